@@ -0,0 +1,79 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// EmptyPolicy specifies the handling of empty entries, which refer to the
+// working directory, by Absolutize.
+type EmptyPolicy int
+
+const (
+	KeepEmpty    EmptyPolicy = iota // keep empty entries as is
+	DropEmpty                       // omit empty entries
+	ResolveEmpty                    // replace empty entries with base
+)
+
+// Absolutize returns list with each relative entry joined to the directory
+// base, and empty entries handled according to empty.
+// The result refers to the same directories as list for a process running in
+// base, regardless of the working directory.
+// Base must be absolute.
+// On Windows, entries rooted without a drive (\tools) are placed on the drive
+// of base, and drive-relative entries (C:tools) are joined to base if it is on
+// the same drive; an error is returned for drive-relative entries on other
+// drives, as their directory depends on the working directory of that drive.
+func Absolutize(list List, base string, empty EmptyPolicy) (List, error) {
+	if !filepath.IsAbs(base) {
+		return "", fmt.Errorf("pathlist: base must be absolute: %q", base)
+	}
+	var fps []string
+	for _, fp := range Split(list) {
+		switch vol := filepath.VolumeName(fp); {
+		case fp == "" && empty == KeepEmpty:
+		case fp == "" && empty == DropEmpty:
+			continue
+		case fp == "":
+			fp = base
+		case filepath.IsAbs(fp):
+		case vol == "" && os.IsPathSeparator(fp[0]):
+			fp = filepath.VolumeName(base) + fp
+		case vol == "":
+			fp = filepath.Join(base, fp)
+		case strings.EqualFold(vol, filepath.VolumeName(base)):
+			fp = filepath.Join(base, fp[len(vol):])
+		default:
+			return "", fmt.Errorf("pathlist: entry %q is relative to drive other than that of base %q",
+				fp, base)
+		}
+		fps = append(fps, fp)
+	}
+	return New(fps...)
+}
+
+// Relativize returns list with each absolute entry within the directory base
+// replaced by a path relative to base (. for base itself), for use in
+// configuration stored alongside base.
+// Other entries are kept as is.
+func Relativize(list List, base string) (List, error) {
+	fps := Split(list)
+	for i, fp := range fps {
+		if !filepath.IsAbs(fp) {
+			continue
+		}
+		rel, err := filepath.Rel(base, fp)
+		if err != nil || rel == ".." ||
+			strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		fps[i] = rel
+	}
+	return New(fps...)
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"runtime"
+	"testing"
+)
+
+var absolutizeTests = []struct {
+	list                List
+	keep, drop, resolve List
+}{
+	{"", "", "", ""},
+	{":", ":", "", "/base"},
+	{"/bin", "/bin", "/bin", "/bin"},
+	{"bin::../lib:/usr/bin:.", "/base/bin::/lib:/usr/bin:/base",
+		"/base/bin:/lib:/usr/bin:/base", "/base/bin:/base:/lib:/usr/bin:/base"},
+}
+
+func TestAbsolutize(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	for _, tt := range absolutizeTests {
+		for _, c := range []struct {
+			empty EmptyPolicy
+			want  List
+		}{{KeepEmpty, tt.keep}, {DropEmpty, tt.drop}, {ResolveEmpty, tt.resolve}} {
+			got, err := Absolutize(tt.list, "/base", c.empty)
+			if err != nil || !equiv(Split(got), Split(c.want)) {
+				t.Errorf("Absolutize(%#q, %q, %d) = %#q, %v; want %#q, nil", tt.list,
+					"/base", c.empty, got, err, c.want)
+			} else {
+				t.Logf("Absolutize(%#q, %q, %d) = %#q, %v", tt.list, "/base",
+					c.empty, got, err)
+			}
+		}
+	}
+}
+
+func TestAbsolutizeRelativeBase(t *testing.T) {
+	if got, err := Absolutize(colonToSep("bin:"), "rel", ResolveEmpty); err == nil {
+		t.Errorf("Absolutize(%#q, %q, ResolveEmpty) = %#q, nil; want error",
+			colonToSep("bin:"), "rel", got)
+	}
+}
+
+var absolutizeWindowsTests = []struct {
+	list List
+	want List // "" for error
+}{
+	{`bin;C:\x`, `C:\base\bin;C:\x`},
+	{`\tools`, `C:\tools`},
+	{`C:tools;c:`, `C:\base\tools;C:\base`},
+	{`D:tools`, ``},
+}
+
+func TestAbsolutizeWindows(t *testing.T) {
+	if runtime.GOOS != "windows" {
+		t.Skip("windows-only test")
+	}
+	for _, tt := range absolutizeWindowsTests {
+		got, err := Absolutize(tt.list, `C:\base`, KeepEmpty)
+		if (err == nil) != (tt.want != "") || got != tt.want {
+			t.Errorf("Absolutize(%#q, %q, KeepEmpty) = %#q, %v; want %#q", tt.list,
+				`C:\base`, got, err, tt.want)
+		} else {
+			t.Logf("Absolutize(%#q, %q, KeepEmpty) = %#q, %v", tt.list, `C:\base`,
+				got, err)
+		}
+	}
+}
+
+var relativizeTests = []struct {
+	list, want List
+}{
+	{"", ""},
+	{":", ":"},
+	{"/repo:/repo/bin:/repo/tools/bin", ".:bin:tools/bin"},
+	{"/usr/bin:/repository/bin:/repo/../bin:rel", "/usr/bin:/repository/bin:/repo/../bin:rel"},
+}
+
+func TestRelativize(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	for _, tt := range relativizeTests {
+		got, err := Relativize(tt.list, "/repo")
+		if err != nil || got != tt.want {
+			t.Errorf("Relativize(%#q, %q) = %#q, %v; want %#q, nil", tt.list, "/repo",
+				got, err, tt.want)
+		} else {
+			t.Logf("Relativize(%#q, %q) = %#q, %v", tt.list, "/repo", got, err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// Builder builds a List from filepaths and Lists in time linear in the size
+// of the result, unlike repeated calls to AppendTo and PrependTo, which copy
+// the list for each filepath.
+// The zero value is an empty Builder ready to use.
+type Builder struct {
+	front []string // prepended elems, in reverse order
+	back  []string // appended elems
+}
+
+// Append appends filepaths if valid, or returns an Error and leaves b
+// unchanged.
+func (b *Builder) Append(filepaths ...string) error {
+	n := len(b.back)
+	for i, fp := range filepaths {
+		e, err := newElem(fp, i)
+		if err != nil {
+			b.back = b.back[:n]
+			return err
+		}
+		b.back = append(b.back, e)
+	}
+	return nil
+}
+
+// Prepend prepends filepaths if valid, or returns an Error and leaves b
+// unchanged.
+// Filepaths appear in the result in the same order as in the argument list.
+func (b *Builder) Prepend(filepaths ...string) error {
+	n := len(b.front)
+	for i := range filepaths {
+		j := len(filepaths) - i - 1
+		e, err := newElem(filepaths[j], j)
+		if err != nil {
+			b.front = b.front[:n]
+			return err
+		}
+		b.front = append(b.front, e)
+	}
+	return nil
+}
+
+// AppendList appends the elements of list as written.
+// On Windows, a quote left unclosed in list is closed at its end, as in
+// AppendTo.
+func (b *Builder) AppendList(list List) {
+	for _, e := range Parse(list).Elems {
+		b.back = append(b.back, e.Raw)
+	}
+	if n := len(b.back); n > 0 {
+		b.back[n-1] = internal.CloseQuote(b.back[n-1])
+	}
+}
+
+// Len returns the number of elements in b.
+func (b *Builder) Len() int {
+	return len(b.front) + len(b.back)
+}
+
+// Reset resets b to be empty.
+func (b *Builder) Reset() {
+	*b = Builder{}
+}
+
+// List returns the List built.
+func (b *Builder) List() List {
+	es := make([]string, 0, b.Len())
+	for i := len(b.front) - 1; i >= 0; i-- {
+		es = append(es, b.front[i])
+	}
+	es = append(es, b.back...)
+	return List(internal.NewList(es...))
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	for _, tt := range appendToTests {
+		var ba, bp Builder
+		ba.AppendList(colonToSep(tt.list))
+		bp.AppendList(colonToSep(tt.list))
+		if err := ba.Append(tt.filepaths...); err != nil {
+			t.Fatal(err)
+		}
+		if err := bp.Prepend(tt.filepaths...); err != nil {
+			t.Fatal(err)
+		}
+		if got := ba.List(); !equiv(Split(got), Split(colonToSep(tt.appended))) {
+			t.Errorf("Builder: AppendList(%q), Append(%q): %#q; want equivalent to %q",
+				tt.list, tt.filepaths, got, tt.appended)
+		}
+		if got := bp.List(); !equiv(Split(got), Split(colonToSep(tt.prepended))) {
+			t.Errorf("Builder: AppendList(%q), Prepend(%q): %#q; want equivalent to %q",
+				tt.list, tt.filepaths, got, tt.prepended)
+		}
+	}
+}
+
+func TestBuilderInvalidFilepath(t *testing.T) {
+	if invalidFilepath == "" {
+		t.Skip("no invalid filepath on this OS")
+	}
+	var b Builder
+	if err := b.Append("a", invalidFilepath); err == nil || b.Len() != 0 {
+		t.Errorf("Append(%q, %q) = %v; Len() = %d; want error, 0", "a",
+			invalidFilepath, err, b.Len())
+	}
+	if err := b.Prepend("a", invalidFilepath); err == nil || b.Len() != 0 {
+		t.Errorf("Prepend(%q, %q) = %v; Len() = %d; want error, 0", "a",
+			invalidFilepath, err, b.Len())
+	}
+}
+
+func benchFilepaths(n int) []string {
+	fps := make([]string, n)
+	for i := range fps {
+		fps[i] = fmt.Sprintf("/opt/lib%d/lib", i)
+	}
+	return fps
+}
+
+func BenchmarkBuilder(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		fps := benchFilepaths(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var bld Builder
+				for _, fp := range fps {
+					bld.Append(fp)
+				}
+				_ = bld.List()
+			}
+		})
+	}
+}
+
+func BenchmarkAppendTo(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		fps := benchFilepaths(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var l List
+				for _, fp := range fps {
+					l, _ = AppendTo(l, fp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Errors holds an ArgError for each invalid filepath argument, in order.
+// It supports errors.Is and errors.As through Unwrap, as the errors returned
+// by errors.Join do.
+type Errors []ArgError
+
+func (es Errors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = fmt.Sprintf("argument %d: %v", e.Index, e)
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the ArgErrors in es.
+func (es Errors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Check validates all filepaths as done by New, AppendTo and PrependTo, and
+// returns an Errors listing every invalid one, or nil if all are valid.
+// Unlike these functions, which stop at the first invalid filepath, Check
+// allows reporting all invalid filepaths at once.
+func Check(filepaths ...string) error {
+	var es Errors
+	for i, fp := range filepaths {
+		if _, err := newElem(fp, i); err != nil {
+			es = append(es, err.(ArgError))
+		}
+	}
+	if es == nil {
+		return nil
+	}
+	return es
+}
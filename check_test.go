@@ -0,0 +1,39 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	if err := Check("a", "", "b"); err != nil {
+		t.Errorf("Check(%q, %q, %q) = %v; want nil", "a", "", "b", err)
+	}
+	if invalidFilepath == "" {
+		t.Skip("no invalid filepath on this OS")
+	}
+	fps := []string{invalidFilepath, "a", invalidFilepath + "x"}
+	err := Check(fps...)
+	es, ok := err.(Errors)
+	if !ok || len(es) != 2 || es[0].Index != 0 || es[1].Index != 2 ||
+		es[1].Path != fps[2] {
+		t.Fatalf("Check(%q) = %#v; want Errors for arguments 0 and 2", fps, err)
+	}
+	t.Logf("Check(%q) = %v", fps, err)
+	var ae ArgError
+	if !errors.As(err, &ae) || ae.Index != 0 {
+		t.Errorf("errors.As(Check(%q), *ArgError) = %v, %+v; want true, index 0",
+			fps, errors.As(err, &ae), ae)
+	}
+	var pe Error
+	if !errors.As(err, &pe) || pe.Filepath() != fps[0] {
+		t.Errorf("errors.As(Check(%q), *Error) = %v; want Error for %q", fps, pe, fps[0])
+	}
+	if _, err := New(fps...); err != error(es[0]) {
+		t.Errorf("New(%q) = _, %v; want %v", fps, err, es[0])
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Pathlist manipulates filepath lists such as PATH from the shell.
+//
+// Usage:
+//
+//	pathlist <command> [-e var | -l list | -stdin] [flags] [args]
+//
+// The input list is read from the environment variable var (PATH by
+// default), from the list argument, or from standard input.
+// Commands that produce a list write it to standard output, so that they can
+// be used as:
+//
+//	PATH=$(pathlist add -front ~/bin)
+//
+// The commands are:
+//
+//	add [-front|-back] fp...  add filepaths to the front or back (default)
+//	rm fp...                  remove all occurrences of filepaths
+//	dedup                     remove all but the first occurrence of entries
+//	split [-0]                print entries one per line (NUL-terminated with -0)
+//	join [-0] [fp...]         join filepaths from args or standard input lines
+//	contains fp               exit with status 0 if fp is in the list, 1 if not
+//	which name                print the executable found for name in the list
+//	lint                      report empty, relative, missing and duplicate entries
+//	diff list2                print entries removed (-) and added (+) in list2
+//
+// Flags must precede the arguments; use -- to pass arguments starting with -.
+// Entries are compared after cleaning with filepath.Clean.
+// Diff compares the lists in order, so that an entry moved relative to others
+// is reported as removed and added at its new position.
+// The exit status is 1 when contains or which find no match (or which only
+// finds a relative one), when lint reports issues and when diff finds
+// differences, and 2 on usage and list errors.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// cmd holds the state of a single command invocation.
+type cmd struct {
+	flags  *flag.FlagSet
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	envVar  string
+	listArg string
+	stdinIn bool
+
+	front, back, nul bool
+}
+
+type command struct {
+	usage string
+	flags func(c *cmd)
+	run   func(c *cmd, list pathlist.List, args []string) int
+}
+
+var commands = map[string]command{
+	"add":      {"[-front|-back] fp...", addFlags, runAdd},
+	"rm":       {"fp...", nil, runRm},
+	"dedup":    {"", nil, runDedup},
+	"split":    {"[-0]", nulFlag, runSplit},
+	"join":     {"[-0] [fp...]", nulFlag, runJoin},
+	"contains": {"fp", nil, runContains},
+	"which":    {"name", nil, runWhich},
+	"lint":     {"", nil, runLint},
+	"diff":     {"list2", nil, runDiff},
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "usage: pathlist <command> [-e var | -l list | -stdin] [flags] [args]")
+		return 2
+	}
+	name := args[0]
+	command, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "pathlist: unknown command %q\n", name)
+		return 2
+	}
+	c := &cmd{
+		flags:  flag.NewFlagSet(name, flag.ContinueOnError),
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+	}
+	c.flags.SetOutput(stderr)
+	c.flags.Usage = func() {
+		fmt.Fprintf(stderr, "usage: pathlist %s [-e var | -l list | -stdin] %s\n",
+			name, command.usage)
+		c.flags.PrintDefaults()
+	}
+	c.flags.StringVar(&c.envVar, "e", env.VarPath, "read the list from environment variable `var`")
+	c.flags.StringVar(&c.listArg, "l", "", "read the list from the `list` argument")
+	c.flags.BoolVar(&c.stdinIn, "stdin", false, "read the list from standard input")
+	if command.flags != nil {
+		command.flags(c)
+	}
+	if err := c.flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+	operands, err := operands(args[1:], c.flags.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "pathlist %s: %v\n", name, err)
+		c.flags.Usage()
+		return 2
+	}
+	if name == "join" {
+		return command.run(c, "", operands)
+	}
+	list, err := c.input()
+	if err != nil {
+		fmt.Fprintf(stderr, "pathlist: %v\n", err)
+		return 2
+	}
+	return command.run(c, list, operands)
+}
+
+// operands returns the arguments rest remaining after parsing args, or an
+// error for the first of them that looks like a flag, as flags must precede
+// arguments.
+// A "--" among rest ends the check, and is removed, as it is when parsing
+// stops at it.
+func operands(args, rest []string) ([]string, error) {
+	if n := len(args) - len(rest); n > 0 && args[n-1] == "--" {
+		return rest, nil
+	}
+	for i, a := range rest {
+		switch {
+		case a == "--":
+			return append(rest[:i:i], rest[i+1:]...), nil
+		case len(a) > 1 && a[0] == '-':
+			return nil, fmt.Errorf("flag %s after arguments", a)
+		}
+	}
+	return rest, nil
+}
+
+// input returns the input list as selected by the flags.
+func (c *cmd) input() (pathlist.List, error) {
+	listSet := false
+	c.flags.Visit(func(f *flag.Flag) {
+		listSet = listSet || f.Name == "l"
+	})
+	switch {
+	case c.stdinIn:
+		data, err := ioutil.ReadAll(c.stdin)
+		if err != nil {
+			return "", err
+		}
+		return pathlist.List(trimNewline(string(data))), nil
+	case listSet:
+		return pathlist.List(c.listArg), nil
+	}
+	return pathlist.List(os.Getenv(c.envVar)), nil
+}
+
+func trimNewline(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return strings.TrimSuffix(s, "\r")
+}
+
+func (c *cmd) fail(err error) int {
+	fmt.Fprintf(c.stderr, "pathlist %s: %v\n", c.flags.Name(), err)
+	return 2
+}
+
+func (c *cmd) output(list pathlist.List, err error) int {
+	if err != nil {
+		return c.fail(err)
+	}
+	fmt.Fprintln(c.stdout, list)
+	return 0
+}
+
+func same(fp1, fp2 string) bool {
+	if fp1 == "" || fp2 == "" {
+		return fp1 == fp2
+	}
+	return filepath.Clean(fp1) == filepath.Clean(fp2)
+}
+
+func index(fps []string, fp string) int {
+	for i, fp2 := range fps {
+		if same(fp, fp2) {
+			return i
+		}
+	}
+	return -1
+}
+
+func addFlags(c *cmd) {
+	c.flags.BoolVar(&c.front, "front", false, "add filepaths to the front of the list")
+	c.flags.BoolVar(&c.back, "back", false, "add filepaths to the back of the list (default)")
+}
+
+func nulFlag(c *cmd) {
+	c.flags.BoolVar(&c.nul, "0", false, "use NUL instead of newline as terminator")
+}
+
+func runAdd(c *cmd, list pathlist.List, args []string) int {
+	if c.front && c.back {
+		c.flags.Usage()
+		return 2
+	}
+	if err := pathlist.Check(args...); err != nil {
+		return c.fail(err)
+	}
+	if c.front {
+		return c.output(pathlist.PrependTo(list, args...))
+	}
+	return c.output(pathlist.AppendTo(list, args...))
+}
+
+func runRm(c *cmd, list pathlist.List, args []string) int {
+	var fps []string
+	for _, fp := range pathlist.Split(list) {
+		if index(args, fp) < 0 {
+			fps = append(fps, fp)
+		}
+	}
+	return c.output(pathlist.New(fps...))
+}
+
+func runDedup(c *cmd, list pathlist.List, args []string) int {
+	var fps []string
+	for _, fp := range pathlist.Split(list) {
+		if index(fps, fp) < 0 {
+			fps = append(fps, fp)
+		}
+	}
+	return c.output(pathlist.New(fps...))
+}
+
+func (c *cmd) terminator() string {
+	if c.nul {
+		return "\x00"
+	}
+	return "\n"
+}
+
+func runSplit(c *cmd, list pathlist.List, args []string) int {
+	for _, fp := range pathlist.Split(list) {
+		fmt.Fprint(c.stdout, fp, c.terminator())
+	}
+	return 0
+}
+
+func runJoin(c *cmd, list pathlist.List, args []string) int {
+	fps := args
+	if len(args) == 0 {
+		data, err := ioutil.ReadAll(c.stdin)
+		if err != nil {
+			return c.fail(err)
+		}
+		s := string(data)
+		if s != "" {
+			fps = strings.Split(strings.TrimSuffix(s, c.terminator()), c.terminator())
+		}
+		if !c.nul {
+			for i, fp := range fps {
+				fps[i] = strings.TrimSuffix(fp, "\r")
+			}
+		}
+	}
+	return c.output(pathlist.New(fps...))
+}
+
+func runContains(c *cmd, list pathlist.List, args []string) int {
+	if len(args) != 1 {
+		c.flags.Usage()
+		return 2
+	}
+	if index(pathlist.Split(list), args[0]) < 0 {
+		return 1
+	}
+	return 0
+}
+
+func runWhich(c *cmd, list pathlist.List, args []string) int {
+	if len(args) != 1 {
+		c.flags.Usage()
+		return 2
+	}
+	path, err := env.LookPath(list, args[0])
+	if err != nil {
+		fmt.Fprintf(c.stderr, "pathlist which: %v\n", err)
+		return 1
+	}
+	fmt.Fprintln(c.stdout, path)
+	return 0
+}
+
+func runLint(c *cmd, list pathlist.List, args []string) int {
+	w := bufio.NewWriter(c.stdout)
+	defer w.Flush()
+	status := 0
+	report := func(i int, fp, msg string) {
+		fmt.Fprintf(w, "%d: %q: %s\n", i, fp, msg)
+		status = 1
+	}
+	fps := pathlist.Split(list)
+	for i, fp := range fps {
+		if fp == "" {
+			report(i, fp, "empty entry")
+			continue
+		}
+		if j := index(fps[:i], fp); j >= 0 {
+			report(i, fp, fmt.Sprintf("duplicate of entry %d", j))
+		}
+		if !filepath.IsAbs(fp) {
+			report(i, fp, "relative entry")
+		}
+		switch fi, err := os.Stat(fp); {
+		case err != nil:
+			report(i, fp, "does not exist")
+		case !fi.IsDir():
+			report(i, fp, "not a directory")
+		}
+	}
+	return status
+}
+
+func runDiff(c *cmd, list pathlist.List, args []string) int {
+	if len(args) != 1 {
+		c.flags.Usage()
+		return 2
+	}
+	fps1, fps2 := pathlist.Split(list), pathlist.Split(pathlist.List(args[0]))
+	// lcs[i][j] is the length of the longest common subsequence of fps1[i:]
+	// and fps2[j:].
+	lcs := make([][]int, len(fps1)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(fps2)+1)
+	}
+	for i := len(fps1) - 1; i >= 0; i-- {
+		for j := len(fps2) - 1; j >= 0; j-- {
+			switch {
+			case same(fps1[i], fps2[j]):
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	status := 0
+	i, j := 0, 0
+	for i < len(fps1) || j < len(fps2) {
+		switch {
+		case i < len(fps1) && j < len(fps2) && same(fps1[i], fps2[j]):
+			i, j = i+1, j+1
+			continue
+		case j == len(fps2) || (i < len(fps1) && lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(c.stdout, "-%s\n", fps1[i])
+			i++
+		default:
+			fmt.Fprintf(c.stdout, "+%s\n", fps2[j])
+			j++
+		}
+		status = 1
+	}
+	return status
+}
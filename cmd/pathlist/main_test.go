@@ -0,0 +1,117 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+var runTests = []struct {
+	args   []string
+	stdin  string
+	status int
+	stdout string
+}{
+	{[]string{"add", "-l", "/a:/b", "/c"}, "", 0, "/a:/b:/c\n"},
+	{[]string{"add", "-l", "/a:/b", "-front", "/c", "/d"}, "", 0, "/c:/d:/a:/b\n"},
+	{[]string{"add", "-l", "", "/c"}, "", 0, "/c\n"},
+	{[]string{"add", "-l", "/a", "/c:/d"}, "", 2, ""},
+	{[]string{"add", "-stdin", "-back", "/c"}, "/a:/b\n", 0, "/a:/b:/c\n"},
+	{[]string{"add", "-e", "PATHLIST_TEST_VAR", "/c"}, "", 0, "/a:/c\n"},
+	{[]string{"rm", "-l", "/a:/b/:/c:/b", "/b"}, "", 0, "/a:/c\n"},
+	{[]string{"dedup", "-l", "/a:/b:/a/:/c:/b"}, "", 0, "/a:/b:/c\n"},
+	{[]string{"split", "-l", "/a::/b"}, "", 0, "/a\n\n/b\n"},
+	{[]string{"split", "-l", "/a:/b", "-0"}, "", 0, "/a\x00/b\x00"},
+	{[]string{"join", "/a", "/b"}, "", 0, "/a:/b\n"},
+	{[]string{"join"}, "/a\n/b\n", 0, "/a:/b\n"},
+	{[]string{"join", "-0"}, "/a\x00/b\x00", 0, "/a:/b\n"},
+	{[]string{"contains", "-l", "/a:/b", "/b/"}, "", 0, ""},
+	{[]string{"contains", "-l", "/a:/b", "/c"}, "", 1, ""},
+	{[]string{"diff", "-l", "/a:/b", "/b:/c"}, "", 1, "-/a\n+/c\n"},
+	{[]string{"diff", "-l", "/a:/b", "/b:/a"}, "", 1, "-/a\n+/a\n"},
+	{[]string{"diff", "-l", "/a:/b/:/c", "/a:/x:/b:/c"}, "", 1, "+/x\n"},
+	{[]string{"diff", "-l", "/a:/b", "/a/:/b"}, "", 0, ""},
+	{[]string{"add", "-l", "/a", "/x", "-front"}, "", 2, ""},
+	{[]string{"add", "-l", "/a", "--", "-x"}, "", 0, "/a:-x\n"},
+	{[]string{"add", "-l", "/a", "/x", "--", "-y"}, "", 0, "/a:/x:-y\n"},
+	{[]string{"add", "-l", "/a", "/x", "--", "--"}, "", 0, "/a:/x:--\n"},
+	{[]string{"which", "-l", "/nonexistent", "--", "-x"}, "", 1, ""},
+	{[]string{"rm", "-l", "/a:-x", "/b", "--", "-x"}, "", 0, "/a\n"},
+	{[]string{"lint", "-l", "/"}, "", 0, ""},
+	{[]string{"lint", "-l", "/::rel:/"}, "", 1, "1: \"\": empty entry\n" +
+		"2: \"rel\": relative entry\n2: \"rel\": does not exist\n" +
+		"3: \"/\": duplicate of entry 0\n"},
+	{[]string{"nonexistent"}, "", 2, ""},
+	{[]string{}, "", 2, ""},
+}
+
+func TestRun(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	t.Setenv("PATHLIST_TEST_VAR", "/a")
+	for _, tt := range runTests {
+		var stdout bytes.Buffer
+		status := run(tt.args, strings.NewReader(tt.stdin), &stdout, ioutil.Discard)
+		if status != tt.status || stdout.String() != tt.stdout {
+			t.Errorf("pathlist %q <%q: %d, %q; want %d, %q", tt.args, tt.stdin,
+				status, stdout.String(), tt.status, tt.stdout)
+		} else {
+			t.Logf("pathlist %q <%q: %d, %q", tt.args, tt.stdin, status, stdout.String())
+		}
+	}
+}
+
+func TestWhichRelative(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "bin"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	exe := filepath.Join(dir, "bin", "pathlist-test-exe")
+	if err := ioutil.WriteFile(exe, []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	args := []string{"which", "-l", "bin", "pathlist-test-exe"}
+	var stdout, stderr bytes.Buffer
+	status := run(args, strings.NewReader(""), &stdout, &stderr)
+	if status != 1 || stdout.Len() != 0 || stderr.Len() == 0 {
+		t.Errorf("pathlist %q: %d, %q, %q; want 1, no output, error", args, status,
+			stdout.String(), stderr.String())
+	} else {
+		t.Logf("pathlist %q: %d, %q", args, status, stderr.String())
+	}
+	args = []string{"which", "-l", filepath.Join(dir, "bin"), "pathlist-test-exe"}
+	stdout.Reset()
+	if status := run(args, strings.NewReader(""), &stdout, ioutil.Discard); status != 0 ||
+		stdout.String() != exe+"\n" {
+		t.Errorf("pathlist %q: %d, %q; want 0, %q", args, status, stdout.String(), exe+"\n")
+	}
+}
+
+// chdir changes the working directory to dir for the rest of the test.
+func chdir(t *testing.T, dir string) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
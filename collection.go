@@ -0,0 +1,73 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// The functions below operate on the elements of lists as written, without
+// revalidating or requoting them.
+// As in New, the empty List has no elements, and the sole ListSeparator is a
+// single empty element; consequently, two empty elements are written as the
+// sole ListSeparator as well.
+
+// Len returns the number of elements in list.
+func Len(list List) int {
+	return len(elems(list))
+}
+
+// At returns the (raw/unquoted) filepath of the i-th element of list.
+// It panics if i is out of range.
+func At(list List, i int) string {
+	return internal.Unquote(elems(list)[i])
+}
+
+// Slice returns the list of the elements of list from index i up to but not
+// including j.
+// It panics if the indices are out of range, as a slice expression does.
+func Slice(list List, i, j int) List {
+	return joinElems(elems(list)[i:j])
+}
+
+// Reverse returns the list of the elements of list in reverse order.
+func Reverse(list List) List {
+	es := elems(list)
+	for i, j := 0, len(es)-1; i < j; i, j = i+1, j-1 {
+		es[i], es[j] = es[j], es[i]
+	}
+	return joinElems(es)
+}
+
+// Concat returns the list of the elements of lists, in order.
+// Unlike joining lists with ListSeparator, empty lists contribute no
+// elements, so that Concat("", "/mybin") returns "/mybin".
+func Concat(lists ...List) List {
+	var es []string
+	for _, l := range lists {
+		es = append(es, elems(l)...)
+	}
+	return joinElems(es)
+}
+
+// elems returns the elements of list as written.
+func elems(list List) []string {
+	switch list {
+	case "":
+		return nil
+	case List(ListSeparator):
+		return []string{""}
+	}
+	return internal.SplitElems(string(list))
+}
+
+// joinElems returns the list of es, closing a quote left unclosed in any
+// element other than the last one (Windows only).
+func joinElems(es []string) List {
+	for i := 0; i < len(es)-1; i++ {
+		es[i] = internal.CloseQuote(es[i])
+	}
+	return List(internal.NewList(es...))
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"runtime"
+	"testing"
+)
+
+var collectionTests = []struct {
+	list    List
+	len     int
+	reverse List
+}{
+	{"", 0, ""},
+	{":", 1, ":"},
+	{"a", 1, "a"},
+	{"a:", 2, ":a"},
+	{"::", 3, "::"},
+	{"a:b:c", 3, "c:b:a"},
+	{"a::b", 3, "b::a"},
+}
+
+func TestLenAtReverse(t *testing.T) {
+	for _, tt := range collectionTests {
+		l := colonToSep(tt.list)
+		fps := Split(l)
+		if got := Len(l); got != tt.len || got != len(fps) {
+			t.Errorf("Len(%#q) = %d; want %d", l, got, tt.len)
+		}
+		for i, fp := range fps {
+			if got := At(l, i); got != fp {
+				t.Errorf("At(%#q, %d) = %q; want %q", l, i, got, fp)
+			}
+		}
+		if got, want := Reverse(l), colonToSep(tt.reverse); got != want {
+			t.Errorf("Reverse(%#q) = %#q; want %#q", l, got, want)
+		} else {
+			t.Logf("Reverse(%#q) = %#q", l, got)
+		}
+	}
+}
+
+var sliceTests = []struct {
+	list List
+	i, j int
+	want List
+}{
+	{"", 0, 0, ""},
+	{"a:b:c", 0, 3, "a:b:c"},
+	{"a:b:c", 1, 2, "b"},
+	{"a:b:c", 1, 1, ""},
+	{"a::c", 1, 2, ":"},
+	{"a::c", 0, 2, "a:"},
+	{":", 0, 1, ":"},
+}
+
+func TestSlice(t *testing.T) {
+	for _, tt := range sliceTests {
+		l := colonToSep(tt.list)
+		if got, want := Slice(l, tt.i, tt.j), colonToSep(tt.want); got != want {
+			t.Errorf("Slice(%#q, %d, %d) = %#q; want %#q", l, tt.i, tt.j, got, want)
+		} else {
+			t.Logf("Slice(%#q, %d, %d) = %#q", l, tt.i, tt.j, got)
+		}
+	}
+}
+
+var concatTests = []struct {
+	lists []List
+	want  List
+}{
+	{nil, ""},
+	{[]List{"", ""}, ""},
+	{[]List{"", "a"}, "a"},
+	{[]List{"a", ""}, "a"},
+	{[]List{":", "a"}, ":a"},
+	{[]List{"a", ":"}, "a:"},
+	{[]List{":", "a:"}, ":a:"},
+	{[]List{"a:b", "c", "d:"}, "a:b:c:d:"},
+}
+
+func TestConcat(t *testing.T) {
+	for _, tt := range concatTests {
+		ls := make([]List, len(tt.lists))
+		var fps []string
+		for i, l := range tt.lists {
+			ls[i] = colonToSep(l)
+			fps = append(fps, Split(ls[i])...)
+		}
+		got, want := Concat(ls...), colonToSep(tt.want)
+		if got != want || !equiv(Split(got), fps) {
+			t.Errorf("Concat(%#q) = %#q; want %#q", ls, got, want)
+		} else {
+			t.Logf("Concat(%#q) = %#q", ls, got)
+		}
+	}
+}
+
+func TestConcatQuoted(t *testing.T) {
+	if runtime.GOOS != "windows" {
+		t.Skip("windows-only test")
+	}
+	for _, tt := range []struct {
+		a, b, want List
+	}{
+		{`"a;b"`, `c`, `"a;b";c`},
+		{`a"b;c`, `d`, `a"b;c";d`},
+		{`a`, `"b;c`, `a;"b;c`},
+	} {
+		if got := Concat(tt.a, tt.b); got != tt.want {
+			t.Errorf("Concat(%#q, %#q) = %#q; want %#q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"strings"
+
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// Mapping maps a filepath prefix between dialects, such as
+// Mapping{Windows: `C:\`, Unix: "/mnt/c/"}.
+// A Mapping applies to a conversion if it has prefixes for both dialects.
+// An empty prefix matches any entry, such as a relative one, in full.
+type Mapping map[Dialect]string
+
+// Convert returns list of dialect from re-encoded in dialect to, replacing
+// the prefix of each entry using mappings.
+// For each entry, the mapping with the longest matching prefix is used; a
+// prefix matches whole path elements only, and on Windows case-insensitively
+// and with either filepath separator.
+// The filepath separators in the rest of the entry are replaced by the
+// preferred one of dialect to, and the entry is quoted as needed.
+// Empty entries are kept as is.
+//
+// Entries without a matching mapping (ErrUnmapped) and converted filepaths
+// that cannot appear in a list of dialect to (see Validate) are omitted, and
+// an Errors reporting each of them is returned along with the list of the
+// rest, as by LenientNew.
+// The Index of each ArgError is that of the entry in list.
+// An error matching ErrDialect is returned if from or to is not a known
+// Dialect.
+func Convert(list List, from, to Dialect, mappings ...Mapping) (List, error) {
+	for _, d := range []Dialect{from, to} {
+		if err := internal.CheckDialect(d); err != nil {
+			return "", err
+		}
+	}
+	fps := internal.FilepathsDialect(from, string(list))
+	elems := make([]string, 0, len(fps))
+	var es Errors
+	for i, fp := range fps {
+		var err error
+		if fp != "" {
+			fp, err = convert(fp, from, to, mappings)
+		}
+		e := ""
+		if err == nil {
+			e, err = internal.NewElemDialect(to, fp)
+		}
+		if err != nil {
+			es = append(es, argError(err, i))
+			continue
+		}
+		elems = append(elems, e)
+	}
+	l := List(internal.NewListDialect(to, elems...))
+	if es == nil {
+		return l, nil
+	}
+	return l, es
+}
+
+func convert(fp string, from, to Dialect, mappings []Mapping) (string, error) {
+	best, pto, rest := -1, "", ""
+	for _, m := range mappings {
+		pf, ok1 := m[from]
+		pt, ok2 := m[to]
+		if !ok1 || !ok2 || len(pf) <= best {
+			continue
+		}
+		if r, ok := trimPrefix(fp, pf, from); ok {
+			best, pto, rest = len(pf), pt, r
+		}
+	}
+	if best < 0 {
+		return "", internal.Error{Cause_: internal.CauseUnmap, Filepath_: fp,
+			Dialect_: from}
+	}
+	fromSeps, toSeps := internal.PathSeparators(from), internal.PathSeparators(to)
+	rest = strings.Map(func(c rune) rune {
+		if strings.ContainsRune(fromSeps, c) {
+			return rune(toSeps[0])
+		}
+		return c
+	}, rest)
+	if rest == "" || pto == "" {
+		return pto + rest, nil
+	}
+	return strings.TrimRight(pto, toSeps) + toSeps[:1] + rest, nil
+}
+
+// trimPrefix returns the part of fp following prefix and any filepath
+// separators, and whether prefix matches whole path elements of fp.
+func trimPrefix(fp, prefix string, d Dialect) (string, bool) {
+	seps := internal.PathSeparators(d)
+	if len(fp) < len(prefix) {
+		return "", false
+	}
+	head, rest := fp[:len(prefix)], fp[len(prefix):]
+	if d == Windows {
+		head, prefix = strings.ToLower(head), strings.ToLower(prefix)
+		head = strings.Replace(head, "/", `\`, -1)
+		prefix = strings.Replace(prefix, "/", `\`, -1)
+	}
+	if head != prefix {
+		return "", false
+	}
+	if prefix == "" {
+		return fp, true
+	}
+	if rest != "" && !strings.ContainsAny(prefix[len(prefix)-1:], seps) &&
+		!strings.ContainsAny(rest[:1], seps) {
+		return "", false
+	}
+	return strings.TrimLeft(rest, seps), true
+}
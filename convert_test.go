@@ -0,0 +1,70 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"errors"
+	"testing"
+)
+
+var wslMappings = []Mapping{
+	{Windows: `C:\`, Unix: "/mnt/c/"},
+	{Windows: `D:\`, Unix: "/mnt/d"},
+	{Windows: `C:\Users\me`, Unix: "/home/me"},
+}
+
+var convertTests = []struct {
+	list     List
+	from, to Dialect
+	want     List
+	bad      []int // indices of entries reported
+}{
+	{"", Windows, Unix, "", nil},
+	{";", Windows, Unix, ":", nil},
+	{`C:\Windows;D:\bin`, Windows, Unix, "/mnt/c/Windows:/mnt/d/bin", nil},
+	{`c:/Windows;d:\`, Windows, Unix, "/mnt/c/Windows:/mnt/d", nil},
+	{`C:\Users\me\bin;C:\Users\mex`, Windows, Unix, "/home/me/bin:/mnt/c/Users/mex", nil},
+	{`"C:\a;b";C:\c`, Windows, Unix, "/mnt/c/a;b:/mnt/c/c", nil},
+	{`C:\a;;E:\x;bin`, Windows, Unix, "/mnt/c/a:", []int{2, 3}},
+	{"/mnt/c/Windows:/mnt/d/bin", Unix, Windows, `C:\Windows;D:\bin`, nil},
+	{"/home/me/go/bin:/mnt/dd", Unix, Windows, `C:\Users\me\go\bin`, []int{1}},
+	{"/mnt/c/a;b:/usr/bin", Unix, Windows, `"C:\a;b"`, []int{1}},
+	{"/mnt/c/a\x00/mnt/c/b", Plan9, Windows, "", []int{0, 1}},
+}
+
+func TestConvert(t *testing.T) {
+	for _, tt := range convertTests {
+		got, err := Convert(tt.list, tt.from, tt.to, wslMappings...)
+		es, _ := err.(Errors)
+		ok := got == tt.want && len(es) == len(tt.bad) && (err == nil) == (es == nil)
+		for i := 0; ok && i < len(es); i++ {
+			var ae ArgError
+			ok = es[i].Index == tt.bad[i] && errors.As(es[i], &ae) &&
+				ae.Index == tt.bad[i]
+		}
+		if !ok {
+			t.Errorf("Convert(%#q, %v, %v) = %#q, %v; want %#q, errors for %v",
+				tt.list, tt.from, tt.to, got, err, tt.want, tt.bad)
+		} else {
+			t.Logf("Convert(%#q, %v, %v) = %#q, %v", tt.list, tt.from, tt.to, got, err)
+		}
+	}
+}
+
+func TestConvertCauses(t *testing.T) {
+	_, err := Convert(`E:\x`, Windows, Unix, wslMappings...)
+	if !errors.Is(err, ErrUnmapped) {
+		t.Errorf("Convert(%#q) error = %v; want ErrUnmapped", `E:\x`, err)
+	}
+	_, err = Convert(`C:\a:b`, Windows, Unix, wslMappings...)
+	if !errors.Is(err, ErrSeparator) {
+		t.Errorf("Convert(%#q) error = %v; want ErrSeparator", `C:\a:b`, err)
+	}
+	got, err := Convert("bin:/x", Unix, Windows, Mapping{Unix: "", Windows: ""},
+		Mapping{Unix: "/", Windows: `C:\`})
+	if err != nil || got != `bin;C:\x` {
+		t.Errorf("Convert(%#q) = %#q, %v; want %#q, nil", "bin:/x", got, err, `bin;C:\x`)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cygpath translates filepath lists between the POSIX form used by
+// Cygwin, MSYS2 and Git Bash, and the Windows form, in the spirit of
+// "cygpath -p".
+//
+// For example, with MSYS2 installed in C:\msys64, the list
+//
+//	/c/Go/bin:/usr/bin
+//
+// translates to
+//
+//	C:\Go\bin;C:\msys64\usr\bin
+//
+// Drives are mounted under the cygdrive prefix ("/cygdrive" on Cygwin, "/"
+// on MSYS2 and Git Bash), the installation directory is the root, and the
+// mount table adds further mount points, as read from the fstab file of the
+// installation (see ParseFstab).
+// The translation itself is done by pathlist.Convert, and the package does
+// not depend on the OS; the lists are handled in the pathlist.Unix and
+// pathlist.Windows dialects.
+package cygpath // import "gopkg.in/pathlist.v0/cygpath"
+
+import (
+	"strings"
+
+	"gopkg.in/pathlist.v0"
+)
+
+// DefaultCygdrive is the default cygdrive prefix, used by Cygwin.
+const DefaultCygdrive = "/cygdrive"
+
+// Translator translates lists for an installation.
+type Translator struct {
+	// Root is the Windows directory mounted as "/", such as `C:\msys64`.
+	// If Root is empty, absolute POSIX filepaths outside the mount points
+	// translate to filepaths relative to the root of the current drive.
+	Root string
+	// Mounts are the mount points in addition to the root and the drives.
+	Mounts []Mount
+	// Cygdrive is the prefix of the drive mount points; "" means
+	// DefaultCygdrive.
+	Cygdrive string
+}
+
+// New returns a Translator for the installation in root with fstab f
+// (which may be nil).
+func New(root string, f *Fstab) *Translator {
+	t := &Translator{Root: root}
+	if f != nil {
+		t.Mounts = f.Mounts
+		t.Cygdrive = f.Cygdrive
+	}
+	return t
+}
+
+// ToWindows translates list from POSIX form to Windows form, quoting
+// entries as needed.
+// Entries are mapped through the most specific mount point; relative entries
+// only have their separators replaced.
+// As with pathlist.Convert, entries that cannot be translated are omitted and
+// reported in a pathlist.Errors along with the list of the rest.
+func (t *Translator) ToWindows(list pathlist.List) (pathlist.List, error) {
+	return pathlist.Convert(list, pathlist.Unix, pathlist.Windows, t.mappings()...)
+}
+
+// ToPosix translates list from Windows form to POSIX form, removing quotes.
+// Filepaths are mapped through the most specific Windows directory, with
+// drive letters matched case-insensitively.
+// As with pathlist.Convert, entries that cannot be translated, such as
+// ones containing ':' after the drive, are omitted and reported in a
+// pathlist.Errors along with the list of the rest.
+func (t *Translator) ToPosix(list pathlist.List) (pathlist.List, error) {
+	return pathlist.Convert(list, pathlist.Windows, pathlist.Unix, t.mappings()...)
+}
+
+func (t *Translator) mappings() []pathlist.Mapping {
+	cygdrive := t.Cygdrive
+	if cygdrive == "" {
+		cygdrive = DefaultCygdrive
+	}
+	cygdrive = strings.TrimRight(cygdrive, "/")
+	ms := []pathlist.Mapping{{pathlist.Unix: "", pathlist.Windows: ""}}
+	for c := 'a'; c <= 'z'; c++ {
+		ms = append(ms, pathlist.Mapping{
+			pathlist.Unix:    cygdrive + "/" + string(c),
+			pathlist.Windows: strings.ToUpper(string(c)) + `:\`,
+		})
+	}
+	if t.Root != "" {
+		ms = append(ms, mapping("/", t.Root))
+	}
+	for _, m := range t.Mounts {
+		ms = append(ms, mapping(m.Posix, m.Windows))
+	}
+	return ms
+}
+
+func mapping(posix, windows string) pathlist.Mapping {
+	return pathlist.Mapping{
+		pathlist.Unix:    posix,
+		pathlist.Windows: strings.Replace(windows, "/", `\`, -1),
+	}
+}
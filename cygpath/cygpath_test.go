@@ -0,0 +1,83 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cygpath_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/cygpath"
+)
+
+const msysFstab = `# /etc/fstab
+C:/msys64/home /home ntfs binary,posix=0,noacl 0 0
+D:/My\040Projects /proj ntfs binary 0 0
+none / cygdrive binary,posix=0,noacl,user 0 0
+none /tmp usertemp binary,posix=0 0 0
+`
+
+func TestParseFstab(t *testing.T) {
+	f, err := cygpath.ParseFstab(strings.NewReader(msysFstab))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []cygpath.Mount{
+		{Windows: "C:/msys64/home", Posix: "/home"},
+		{Windows: "D:/My Projects", Posix: "/proj"},
+	}
+	if f.Cygdrive != "/" || len(f.Mounts) != len(want) ||
+		f.Mounts[0] != want[0] || f.Mounts[1] != want[1] {
+		t.Errorf("ParseFstab() = %+v; want mounts %+v, cygdrive %q", f, want, "/")
+	}
+	if _, err := cygpath.ParseFstab(strings.NewReader("C:/x /x\n")); err == nil {
+		t.Errorf("ParseFstab(%q) = _, nil; want error", "C:/x /x\n")
+	}
+}
+
+var translateTests = []struct {
+	cygdrive string
+	posix    pathlist.List
+	windows  pathlist.List
+}{
+	{"/", "", ""},
+	{"/", "/c/Go/bin:/usr/bin", `C:\Go\bin;C:\msys64\usr\bin`},
+	{"/", "/home/me/bin:/proj/x", `C:\msys64\home\me\bin;D:\My Projects\x`},
+	{"/", "/d/a;b:bin/sub", `"D:\a;b";bin\sub`},
+	{"/", "/:/c", `C:\msys64;C:\`},
+	{"", "/cygdrive/c/Go/bin:/usr/bin", `C:\Go\bin;C:\msys64\usr\bin`},
+	{"", "/cygdrive/e:/etc", `E:\;C:\msys64\etc`},
+}
+
+func TestTranslator(t *testing.T) {
+	f, err := cygpath.ParseFstab(strings.NewReader(msysFstab))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range translateTests {
+		tr := cygpath.New(`C:\msys64`, f)
+		tr.Cygdrive = tt.cygdrive
+		if got, err := tr.ToWindows(tt.posix); err != nil || got != tt.windows {
+			t.Errorf("cygdrive %q: ToWindows(%#q) = %#q, %v; want %#q, nil",
+				tt.cygdrive, tt.posix, got, err, tt.windows)
+		}
+		if got, err := tr.ToPosix(tt.windows); err != nil || got != tt.posix {
+			t.Errorf("cygdrive %q: ToPosix(%#q) = %#q, %v; want %#q, nil",
+				tt.cygdrive, tt.windows, got, err, tt.posix)
+		} else {
+			t.Logf("cygdrive %q: %#q <-> %#q", tt.cygdrive, tt.posix, tt.windows)
+		}
+	}
+}
+
+func TestToPosixLowercaseDrive(t *testing.T) {
+	tr := cygpath.New(`C:\msys64`, &cygpath.Fstab{Cygdrive: "/"})
+	got, err := tr.ToPosix(`c:\msys64\usr\bin;"c:\Program Files\Git\cmd";D:\x:y`)
+	want := pathlist.List("/usr/bin:/c/Program Files/Git/cmd")
+	if got != want || !errors.Is(err, pathlist.ErrSeparator) {
+		t.Errorf("ToPosix() = %#q, %v; want %#q, ErrSeparator", got, err, want)
+	}
+}
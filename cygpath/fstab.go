@@ -0,0 +1,92 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cygpath
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Mount is an entry of the mount table, mapping a Windows directory to a
+// POSIX mount point.
+type Mount struct {
+	Windows string // such as `C:\msys64\home` or "C:/msys64/home"
+	Posix   string // such as "/home"
+}
+
+// Fstab is a parsed fstab file.
+type Fstab struct {
+	Mounts []Mount
+	// Cygdrive is the prefix of the drive mount points, as set by the
+	// cygdrive entry, or "" if there is none.
+	Cygdrive string
+}
+
+// ParseFstab parses an fstab file, such as /etc/fstab of a Cygwin or MSYS2
+// installation.
+// Each line holds the fields
+//
+//	windows-path posix-path fs-type options dump pass
+//
+// separated by blanks, with spaces and other characters in paths escaped as
+// octal \ooo sequences, as in "\040" for a space.
+// Blank lines and lines starting with '#' are ignored.
+// An entry with fs-type "cygdrive" sets the Cygdrive prefix; other entries
+// are added to Mounts, except for those without a fixed Windows path, which
+// are skipped: the ones with windows-path "none" or with fs-type "usertemp"
+// (mounting the user's Windows temporary directory, as /tmp in MSYS2).
+func ParseFstab(r io.Reader) (*Fstab, error) {
+	f := &Fstab{}
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("cygpath: fstab line %d: missing fields", n)
+		}
+		win, posix := unescape(fields[0]), unescape(fields[1])
+		switch {
+		case fields[2] == "cygdrive":
+			f.Cygdrive = posix
+			continue
+		case fields[0] == "none", fields[2] == "usertemp":
+			continue
+		}
+		f.Mounts = append(f.Mounts, Mount{Windows: win, Posix: posix})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// unescape decodes the octal \ooo sequences in s.
+func unescape(s string) string {
+	if strings.IndexByte(s, '\\') < 0 {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+4 > len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		c, err := strconv.ParseUint(s[i+1:i+4], 8, 8)
+		if err != nil {
+			// a Windows path separator rather than an escape
+			b.WriteByte(s[i])
+			continue
+		}
+		b.WriteByte(byte(c))
+		i += 3
+	}
+	return b.String()
+}
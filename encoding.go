@@ -0,0 +1,79 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// MarshalText implements encoding.TextMarshaler, returning list in the
+// OS-specific format.
+func (list List) MarshalText() ([]byte, error) {
+	return []byte(list), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting a list in the
+// OS-specific format.
+// A QuoteError is returned if text contains a quote left unclosed, and an
+// Error if it holds a filepath rejected by Validate, such as one containing
+// NUL.
+func (list *List) UnmarshalText(text []byte) error {
+	l := List(text)
+	if err := checkList(l); err != nil {
+		return err
+	}
+	*list = l
+	return nil
+}
+
+// checkList returns a QuoteError if list contains a quote left unclosed, an
+// ArgError for the first filepath in list rejected by Validate for the Native
+// dialect, or nil otherwise.
+func checkList(list List) error {
+	if err := CheckQuotes(list); err != nil {
+		return err
+	}
+	for i, fp := range Split(list) {
+		if err := internal.ValidateElem(Native, fp); err != nil {
+			return argError(err, i)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding list as an array of the
+// (raw/unquoted) filepaths returned by Split.
+// Unlike the OS-specific format, the array can be decoded on any OS.
+func (list List) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Split(list))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting either a string in the
+// OS-specific format or an array of (raw/unquoted) filepaths.
+// A string is validated as by UnmarshalText; filepaths in an array are
+// validated as by New, and an Error is returned if there is an invalid
+// filepath.
+func (list *List) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return list.UnmarshalText([]byte(s))
+	}
+	var fps []string
+	if err := json.Unmarshal(data, &fps); err != nil {
+		return err
+	}
+	l, err := New(fps...)
+	if err != nil {
+		return err
+	}
+	*list = l
+	return nil
+}
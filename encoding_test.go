@@ -0,0 +1,119 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"runtime"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler   = List("")
+	_ encoding.TextUnmarshaler = (*List)(nil)
+	_ json.Marshaler           = List("")
+	_ json.Unmarshaler         = (*List)(nil)
+)
+
+var jsonTests = []struct {
+	list List
+	json string
+}{
+	{"", `[]`},
+	{":", `[""]`},
+	{"a", `["a"]`},
+	{"a:", `["a",""]`},
+	{"a:b", `["a","b"]`},
+}
+
+func TestMarshalJSON(t *testing.T) {
+	for _, tt := range jsonTests {
+		l := colonToSep(tt.list)
+		got, err := json.Marshal(l)
+		if err != nil || string(got) != tt.json {
+			t.Errorf("json.Marshal(%#q) = %s, %v; want %s, nil", l, got, err, tt.json)
+			continue
+		}
+		var l2 List
+		if err := json.Unmarshal(got, &l2); err != nil || l2 != l {
+			t.Errorf("json.Unmarshal(%s) = %#q, %v; want %#q, nil", got, l2, err, l)
+		} else {
+			t.Logf("json.Marshal(%#q) = %s", l, got)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var l List
+	data, _ := json.Marshal(string(colonToSep("a:b")))
+	if err := json.Unmarshal(data, &l); err != nil || l != colonToSep("a:b") {
+		t.Errorf("json.Unmarshal(%s) = %#q, %v; want %#q, nil", data, l, err,
+			colonToSep("a:b"))
+	}
+	if err := json.Unmarshal([]byte(`1`), &l); err == nil {
+		t.Errorf("json.Unmarshal(%s) = %#q, nil; want error", `1`, l)
+	}
+	if invalidFilepath == "" {
+		return
+	}
+	data, _ = json.Marshal([]string{"a", invalidFilepath})
+	err := json.Unmarshal(data, &l)
+	if _, ok := err.(Error); !ok {
+		t.Errorf("json.Unmarshal(%s) = %v; want Error", data, err)
+	} else {
+		t.Logf("json.Unmarshal(%s) = %v", data, err)
+	}
+}
+
+func TestText(t *testing.T) {
+	l := colonToSep("a::b")
+	text, err := l.MarshalText()
+	if err != nil || string(text) != string(l) {
+		t.Errorf("%#q.MarshalText() = %q, %v; want %q, nil", l, text, err, l)
+	}
+	var l2 List
+	if err := l2.UnmarshalText(text); err != nil || l2 != l {
+		t.Errorf("UnmarshalText(%q) = %#q, %v; want %#q, nil", text, l2, err, l)
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	if runtime.GOOS == "plan9" {
+		t.Skip("NUL is the separator on Plan 9")
+	}
+	for _, tt := range []struct {
+		goos  string // "" for any OS
+		text  List
+		index int // index of the invalid filepath; -1 for a QuoteError
+		cause error
+	}{
+		{"", colonToSep("a:b\x00c"), 1, ErrNulByte},
+		{"windows", `a;"b;c`, -1, ErrUnclosed},
+	} {
+		if tt.goos != "" && tt.goos != runtime.GOOS {
+			continue
+		}
+		l := List("unchanged")
+		err := l.UnmarshalText([]byte(tt.text))
+		var ae ArgError
+		switch {
+		case !errors.Is(err, tt.cause) || l != "unchanged":
+			t.Errorf("UnmarshalText(%q) = %#q, %v; want unchanged, %v", tt.text, l,
+				err, tt.cause)
+		case tt.index >= 0 && (!errors.As(err, &ae) || ae.Index != tt.index):
+			t.Errorf("UnmarshalText(%q) = %#v; want ArgError with Index %d", tt.text,
+				err, tt.index)
+		default:
+			t.Logf("UnmarshalText(%q) = %v", tt.text, err)
+		}
+		data, _ := json.Marshal(string(tt.text))
+		if err := json.Unmarshal(data, &l); !errors.Is(err, tt.cause) || l != "unchanged" {
+			t.Errorf("json.Unmarshal(%s) = %#q, %v; want unchanged, %v", data, l, err,
+				tt.cause)
+		}
+	}
+}
@@ -36,29 +36,63 @@ func SetGopath(l pathlist.List) error {
 	return os.Setenv(VarGopath, string(l))
 }
 
-// Slice gets the value for key as a pathlist.List from a slice of environment
-// variables (as used with os.Environ and os/exec.Cmd.Env).
-func Slice(env []string, key string) pathlist.List {
-	keyEq := key + "="
+// hasKey reports whether kv, an entry in a slice of environment variables, is
+// for key, ignoring case if fold is set.
+func hasKey(kv, key string, fold bool) bool {
+	if len(kv) <= len(key) || kv[len(key)] != '=' {
+		return false
+	}
+	if fold {
+		return strings.EqualFold(kv[:len(key)], key)
+	}
+	return kv[:len(key)] == key
+}
+
+// lookupSlice is SliceLookup(env)(key), ignoring the case of key if fold is
+// set.
+func lookupSlice(env []string, key string, fold bool) (string, bool) {
 	for _, kv := range env {
-		if strings.HasPrefix(kv, keyEq) {
-			return pathlist.List(kv[len(keyEq):])
+		if hasKey(kv, key, fold) {
+			return kv[len(key)+1:], true
 		}
 	}
-	return ""
+	return "", false
 }
 
-// SetSlice takes a slice of environment variables (as used with os.Environ and
-// os/exec.Cmd.Env), and returns a copy of env with key set to list.
-func SetSlice(env []string, key string, list pathlist.List) []string {
-	keyEq := key + "="
-	keyEqVal := keyEq + string(list)
+// setSlice is SetSlice, ignoring the case of key if fold is set.
+func setSlice(env []string, key string, list pathlist.List, fold bool) []string {
 	for i, kv := range env {
-		if strings.HasPrefix(kv, keyEq) {
+		if hasKey(kv, key, fold) {
 			env := append([]string(nil), env...)
-			env[i] = keyEqVal
+			env[i] = kv[:len(key)+1] + string(list)
 			return env
 		}
 	}
-	return append(append(make([]string, 0, len(env)+1), env...), keyEqVal)
+	return append(append(make([]string, 0, len(env)+1), env...), key+"="+string(list))
+}
+
+// Slice gets the value for key as a pathlist.List from a slice of environment
+// variables (as used with os.Environ and os/exec.Cmd.Env).
+// On Windows, key is matched case-insensitively, as in os.Getenv.
+func Slice(env []string, key string) pathlist.List {
+	val, _ := lookupSlice(env, key, foldKeys)
+	return pathlist.List(val)
+}
+
+// SliceLookup returns a function looking up variables in a slice of
+// environment variables (as used with os.Environ and os/exec.Cmd.Env), with
+// the same signature as os.LookupEnv.
+// On Windows, keys are matched case-insensitively.
+func SliceLookup(env []string) func(key string) (string, bool) {
+	return func(key string) (string, bool) {
+		return lookupSlice(env, key, foldKeys)
+	}
+}
+
+// SetSlice takes a slice of environment variables (as used with os.Environ and
+// os/exec.Cmd.Env), and returns a copy of env with key set to list.
+// On Windows, key is matched case-insensitively, and an existing variable
+// keeps the case of its name (such as Path).
+func SetSlice(env []string, key string, list pathlist.List) []string {
+	return setSlice(env, key, list, foldKeys)
 }
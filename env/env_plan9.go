@@ -4,5 +4,17 @@
 
 package env
 
+import (
+	"gopkg.in/pathlist.v0"
+)
+
 // VarPath is the OS (shell) specific executable search path variable name.
 const VarPath = "path"
+
+var knownVars = []Var{
+	{Name: VarPath, Separator: pathlist.ListSeparator},
+	{Name: VarGopath, Separator: pathlist.ListSeparator},
+}
+
+// foldKeys is whether environment variable names are case-insensitive.
+const foldKeys = false
@@ -46,3 +46,16 @@ func TestSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestSliceLookup(t *testing.T) {
+	lookup := env.SliceLookup([]string{"A=a", "EMPTY="})
+	for _, tt := range []struct {
+		key, val string
+		ok       bool
+	}{{"A", "a", true}, {"EMPTY", "", true}, {"B", "", false}} {
+		if val, ok := lookup(tt.key); val != tt.val || ok != tt.ok {
+			t.Errorf("SliceLookup(...)(%q) = %q, %v; want %q, %v", tt.key, val, ok,
+				tt.val, tt.ok)
+		}
+	}
+}
@@ -6,5 +6,41 @@
 
 package env
 
+import (
+	"runtime"
+
+	"gopkg.in/pathlist.v0"
+)
+
 // VarPath is the OS (shell) specific executable search path variable name.
 const VarPath = "PATH"
+
+var knownVars = func() []Var {
+	vars := []Var{
+		{Name: VarPath, Separator: pathlist.ListSeparator, Empty: EmptyWorkdir},
+		{Name: VarGopath, Separator: pathlist.ListSeparator},
+		{Name: VarLdLibraryPath, Separator: pathlist.ListSeparator,
+			Empty: EmptyWorkdir},
+		{Name: VarManpath, Separator: pathlist.ListSeparator, Empty: EmptyDefault},
+		{Name: VarInfopath, Separator: pathlist.ListSeparator,
+			Empty: EmptyTrailingDefault},
+		{Name: VarPkgConfigPath, Separator: pathlist.ListSeparator},
+		{Name: VarPythonpath, Separator: pathlist.ListSeparator},
+		{Name: VarNodePath, Separator: pathlist.ListSeparator},
+		{Name: VarClasspath, Separator: ':', Default: "."},
+		{Name: VarCdpath, Separator: pathlist.ListSeparator, Empty: EmptyWorkdir},
+		{Name: VarXdgDataDirs, Separator: pathlist.ListSeparator,
+			Default: "/usr/local/share/:/usr/share/", DefaultIfEmpty: true},
+		{Name: VarXdgConfigDirs, Separator: pathlist.ListSeparator,
+			Default: "/etc/xdg", DefaultIfEmpty: true},
+		{Name: VarPerl5lib, Separator: pathlist.ListSeparator},
+		{Name: VarGemPath, Separator: pathlist.ListSeparator},
+	}
+	if runtime.GOOS == "darwin" {
+		vars = append(vars, Var{Name: VarDyldLibraryPath, Separator: pathlist.ListSeparator})
+	}
+	return vars
+}()
+
+// foldKeys is whether environment variable names are case-insensitive.
+const foldKeys = false
@@ -4,5 +4,23 @@
 
 package env
 
+import (
+	"gopkg.in/pathlist.v0"
+)
+
 // VarPath is the OS (shell) specific executable search path variable name.
 const VarPath = "PATH"
+
+var knownVars = []Var{
+	{Name: VarPath, Separator: pathlist.ListSeparator},
+	{Name: VarGopath, Separator: pathlist.ListSeparator},
+	{Name: VarPkgConfigPath, Separator: pathlist.ListSeparator},
+	{Name: VarPythonpath, Separator: pathlist.ListSeparator},
+	{Name: VarNodePath, Separator: pathlist.ListSeparator},
+	{Name: VarClasspath, Separator: ';', Default: "."},
+	{Name: VarPerl5lib, Separator: pathlist.ListSeparator},
+	{Name: VarGemPath, Separator: pathlist.ListSeparator},
+}
+
+// foldKeys is whether environment variable names are case-insensitive.
+const foldKeys = true
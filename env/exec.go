@@ -0,0 +1,67 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"gopkg.in/pathlist.v0"
+)
+
+// LookPath searches for an executable named file in the directories of path,
+// following the rules of os/exec.LookPath for the current OS.
+// Unlike exec.LookPath, it searches path rather than the search path of the
+// current process.
+// As with exec.LookPath, a relative result is returned together with an
+// exec.Error wrapping exec.ErrDot.
+func LookPath(path pathlist.List, file string) (string, error) {
+	if isPathName(file) {
+		f, err := findExecutable(file)
+		if err != nil {
+			return "", &exec.Error{Name: file, Err: err}
+		}
+		return f, nil
+	}
+	for _, dir := range pathlist.Split(path) {
+		dir, ok := searchDir(dir)
+		if !ok {
+			continue
+		}
+		f, err := findExecutable(filepath.Join(dir, file))
+		if err != nil {
+			continue
+		}
+		if !filepath.IsAbs(f) {
+			return f, &exec.Error{Name: file, Err: exec.ErrDot}
+		}
+		return f, nil
+	}
+	return "", &exec.Error{Name: file, Err: exec.ErrNotFound}
+}
+
+// Command returns an exec.Cmd to execute the named program with the given
+// arguments, in the environment of the current process with the variables in
+// vars (such as VarPath and VarGopath) set to the given lists.
+// If name contains no path separators, it is resolved using LookPath against
+// the VarPath value in the new environment, not the search path of the
+// current process; lookup errors are reported in the Err field of the result.
+func Command(vars map[string]pathlist.List, name string, arg ...string) *exec.Cmd {
+	env := os.Environ()
+	for key, list := range vars {
+		env = SetSlice(env, key, list)
+	}
+	cmd := exec.Command(name, arg...)
+	cmd.Env = env
+	if filepath.Base(name) == name {
+		lp, err := LookPath(Slice(env, VarPath), name)
+		cmd.Path, cmd.Err = name, err
+		if lp != "" {
+			cmd.Path = lp
+		}
+	}
+	return cmd
+}
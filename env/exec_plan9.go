@@ -0,0 +1,38 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env
+
+import (
+	"os"
+	"strings"
+	"syscall"
+)
+
+func isPathName(file string) bool {
+	for _, p := range []string{"/", "#", "./", "../"} {
+		if strings.HasPrefix(file, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func searchDir(elem string) (string, bool) {
+	return elem, true
+}
+
+func findExecutable(file string) (string, error) {
+	fi, err := os.Stat(file)
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		return "", syscall.EPLAN9
+	}
+	if fi.Mode()&0111 == 0 {
+		return "", os.ErrPermission
+	}
+	return file, nil
+}
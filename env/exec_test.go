@@ -0,0 +1,99 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+// mkbin creates a directory holding an executable file named name and a
+// non-executable file named name+"-noexec".
+func mkbin(t *testing.T, name string) string {
+	dir, err := ioutil.TempDir("", "env_test.mkbin_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name),
+		[]byte("#!/bin/sh\necho ok\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+"-noexec"),
+		[]byte("#!/bin/sh\necho ok\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestLookPath(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	dir := mkbin(t, "pathlist-lookpath")
+	defer os.RemoveAll(dir)
+	path := pathlist.Must(pathlist.New("/nonexistent", dir))
+
+	want := filepath.Join(dir, "pathlist-lookpath")
+	if got, err := env.LookPath(path, "pathlist-lookpath"); got != want || err != nil {
+		t.Errorf("LookPath(%#q, %q) = %q, %v; want %q, nil", path,
+			"pathlist-lookpath", got, err, want)
+	}
+	for _, file := range []string{"pathlist-lookpath-noexec", "pathlist-nonexistent"} {
+		if got, err := env.LookPath(path, file); !errors.Is(err, exec.ErrNotFound) {
+			t.Errorf("LookPath(%#q, %q) = %q, %v; want ErrNotFound", path, file, got, err)
+		}
+	}
+	if got, err := env.LookPath("", "pathlist-lookpath"); !errors.Is(err, exec.ErrNotFound) {
+		t.Errorf("LookPath(%#q, %q) = %q, %v; want ErrNotFound", "",
+			"pathlist-lookpath", got, err)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	dir := mkbin(t, "pathlist-command")
+	defer os.RemoveAll(dir)
+	path, err := pathlist.PrependTo(env.Path(), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gopath := pathlist.Must(pathlist.New(dir))
+
+	cmd := env.Command(map[string]pathlist.List{
+		env.VarPath:   path,
+		env.VarGopath: gopath,
+	}, "pathlist-command", "arg")
+	if cmd.Err != nil {
+		t.Fatalf("Command: Err = %v", cmd.Err)
+	}
+	if want := filepath.Join(dir, "pathlist-command"); cmd.Path != want {
+		t.Errorf("Command: Path = %q; want %q", cmd.Path, want)
+	}
+	if got := env.Slice(cmd.Env, env.VarPath); got != path {
+		t.Errorf("Command: Env %s = %#q; want %#q", env.VarPath, got, path)
+	}
+	if got := env.Slice(cmd.Env, env.VarGopath); got != gopath {
+		t.Errorf("Command: Env %s = %#q; want %#q", env.VarGopath, got, gopath)
+	}
+	out, err := cmd.Output()
+	if err != nil || string(out) != "ok\n" {
+		t.Errorf("Command: Output() = %q, %v; want %q, nil", out, err, "ok\n")
+	}
+
+	cmd = env.Command(nil, "pathlist-command")
+	if !errors.Is(cmd.Err, exec.ErrNotFound) {
+		t.Errorf("Command(nil, %q): Err = %v; want ErrNotFound", "pathlist-command", cmd.Err)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build darwin || dragonfly || freebsd || linux || nacl || netbsd || openbsd || solaris
+// +build darwin dragonfly freebsd linux nacl netbsd openbsd solaris
+
+package env
+
+import (
+	"os"
+	"strings"
+	"syscall"
+)
+
+func isPathName(file string) bool {
+	return strings.Contains(file, "/")
+}
+
+func searchDir(elem string) (string, bool) {
+	// Unix shell semantics: empty element means the working directory
+	if elem == "" {
+		return ".", true
+	}
+	return elem, true
+}
+
+func findExecutable(file string) (string, error) {
+	fi, err := os.Stat(file)
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		return "", syscall.EISDIR
+	}
+	if fi.Mode()&0111 == 0 {
+		return "", os.ErrPermission
+	}
+	return file, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func isPathName(file string) bool {
+	return strings.ContainsAny(file, `:\/`)
+}
+
+func searchDir(elem string) (string, bool) {
+	// empty elements are skipped, consistent with cmd and PowerShell
+	return elem, elem != ""
+}
+
+func pathExts() []string {
+	x := os.Getenv("PATHEXT")
+	if x == "" {
+		return []string{".com", ".exe", ".bat", ".cmd"}
+	}
+	var exts []string
+	for _, e := range strings.Split(strings.ToLower(x), ";") {
+		if e == "" {
+			continue
+		}
+		if e[0] != '.' {
+			e = "." + e
+		}
+		exts = append(exts, e)
+	}
+	return exts
+}
+
+func chkStat(file string) error {
+	fi, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		return os.ErrPermission
+	}
+	return nil
+}
+
+func findExecutable(file string) (string, error) {
+	exts := pathExts()
+	if ext := strings.ToLower(filepath.Ext(file)); ext != "" {
+		for _, e := range exts {
+			if e == ext && chkStat(file) == nil {
+				return file, nil
+			}
+		}
+	}
+	for _, e := range exts {
+		if f := file + e; chkStat(f) == nil {
+			return f, nil
+		}
+	}
+	return "", os.ErrNotExist
+}
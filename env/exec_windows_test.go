@@ -0,0 +1,147 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+// mkbinWindows creates a directory holding the (empty) files named names.
+func mkbinWindows(t *testing.T, names ...string) string {
+	dir := t.TempDir()
+	for _, name := range names {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLookPathWindows(t *testing.T) {
+	dir := mkbinWindows(t, "prog.bat", "tool.exe", "notes.txt", "both.com", "both.exe")
+	path := pathlist.Must(pathlist.New(`C:\nonexistent`, dir))
+	for _, tt := range []struct {
+		pathext string
+		file    string
+		want    string // "" for ErrNotFound
+	}{
+		{"", "prog", "prog.bat"}, // default PATHEXT
+		{".COM;.EXE;.BAT", "prog", "prog.bat"},
+		{".COM;.EXE;.BAT", "PROG", "PROG.bat"}, // file names are case-insensitive
+		{".COM;.EXE", "prog", ""},
+		{"exe;;.bat", "tool", "tool.exe"}, // missing dot, empty entry
+		{".COM;.EXE;.BAT", "both", "both.com"},
+		{".EXE;.COM", "both", "both.exe"},
+		{".COM;.EXE;.BAT", "prog.bat", "prog.bat"}, // extension in PATHEXT
+		{".COM;.EXE;.BAT", "tool.EXE", "tool.EXE"},
+		{".COM;.EXE;.BAT", "notes.txt", ""}, // extension not in PATHEXT
+		{".COM;.EXE;.BAT", "nonexistent", ""},
+	} {
+		t.Setenv("PATHEXT", tt.pathext)
+		got, err := env.LookPath(path, tt.file)
+		switch {
+		case tt.want == "" && !errors.Is(err, exec.ErrNotFound):
+			t.Errorf("PATHEXT=%s: LookPath(%#q, %q) = %q, %v; want ErrNotFound",
+				tt.pathext, path, tt.file, got, err)
+		case tt.want != "" && (err != nil || !sameFile(got, filepath.Join(dir, tt.want))):
+			t.Errorf("PATHEXT=%s: LookPath(%#q, %q) = %q, %v; want %q, nil",
+				tt.pathext, path, tt.file, got, err, filepath.Join(dir, tt.want))
+		default:
+			t.Logf("PATHEXT=%s: LookPath(%#q, %q) = %q, %v", tt.pathext, path,
+				tt.file, got, err)
+		}
+	}
+}
+
+func TestLookPathWindowsErrDot(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "bin"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "bin", "prog.exe"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	chdir(t, dir)
+	t.Setenv("PATHEXT", ".EXE")
+	got, err := env.LookPath("bin", "prog")
+	if want := filepath.Join("bin", "prog.exe"); got != want || !errors.Is(err, exec.ErrDot) {
+		t.Errorf("LookPath(%#q, %q) = %q, %v; want %q, ErrDot", "bin", "prog", got,
+			err, want)
+	}
+}
+
+// pathEntries returns the entries of env for the search path variable, whose
+// name may be Path or PATH on Windows.
+func pathEntries(env []string) []string {
+	var kvs []string
+	for _, kv := range env {
+		if len(kv) > len("PATH=") && strings.EqualFold(kv[:len("PATH=")], "PATH=") {
+			kvs = append(kvs, kv)
+		}
+	}
+	return kvs
+}
+
+func TestCommandWindows(t *testing.T) {
+	dir := mkbinWindows(t, "prog.bat")
+	t.Setenv("PATHEXT", ".COM;.EXE;.BAT")
+	for _, tt := range []struct {
+		inherited pathlist.List
+		vars      map[string]pathlist.List
+	}{
+		{inherited: pathlist.List(dir)},
+		{inherited: `C:\nonexistent`,
+			vars: map[string]pathlist.List{env.VarPath: pathlist.List(dir)}},
+	} {
+		// the inherited search path variable is named Path on Windows
+		t.Setenv("Path", string(tt.inherited))
+		cmd := env.Command(tt.vars, "prog")
+		kvs := pathEntries(cmd.Env)
+		switch {
+		case cmd.Err != nil || !sameFile(cmd.Path, filepath.Join(dir, "prog.bat")):
+			t.Errorf("Command(%v, %q): Path, Err = %q, %v; want %q, nil", tt.vars,
+				"prog", cmd.Path, cmd.Err, filepath.Join(dir, "prog.bat"))
+		case len(kvs) != 1 || env.Slice(cmd.Env, env.VarPath) != pathlist.List(dir):
+			t.Errorf("Command(%v, %q): Env path entries = %q; want one, with value %q",
+				tt.vars, "prog", kvs, dir)
+		default:
+			t.Logf("Command(%v, %q): Path = %q; Env path entries = %q", tt.vars,
+				"prog", cmd.Path, kvs)
+		}
+	}
+}
+
+// sameFile reports whether f1 and f2 name the same file, as the case of
+// names may differ on Windows.
+func sameFile(f1, f2 string) bool {
+	fi1, err1 := os.Stat(f1)
+	fi2, err2 := os.Stat(f2)
+	return err1 == nil && err2 == nil && os.SameFile(fi1, fi2)
+}
+
+// chdir changes the working directory to dir for the rest of the test.
+func chdir(t *testing.T, dir string) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
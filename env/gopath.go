@@ -0,0 +1,121 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"gopkg.in/pathlist.v0"
+)
+
+// EffectiveGopath returns the Go workspace path as used by the go command.
+// Like the go command, it reads GOPATH from the environment or, if unset or
+// empty there, from the go environment file (see GOENV in 'go help
+// environment'), and defaults to the go subdirectory of the user's home
+// directory.
+// Empty entries and entries equal to GOROOT are omitted from the result, and
+// an error is returned for entries rejected by the go command (relative ones
+// and ones starting with '~').
+func EffectiveGopath() (pathlist.List, error) {
+	gopath := goenv(VarGopath)
+	if gopath == "" {
+		return pathlist.New(defaultGopath()...)
+	}
+	goroot := filepath.Clean(goenv("GOROOT"))
+	var fps []string
+	for _, fp := range pathlist.Split(pathlist.List(gopath)) {
+		switch {
+		case fp == "":
+			continue
+		case strings.HasPrefix(fp, "~"):
+			return "", fmt.Errorf("env: GOPATH entry cannot start with shell metacharacter '~': %q", fp)
+		case !filepath.IsAbs(fp):
+			return "", fmt.Errorf("env: GOPATH entry is relative; must be absolute path: %q", fp)
+		case filepath.Clean(fp) == goroot:
+			continue
+		}
+		fps = append(fps, fp)
+	}
+	return pathlist.New(fps...)
+}
+
+// GopathBin returns the directories where the go command installs
+// executables: GOBIN if set, or the bin subdirectory of each entry of
+// EffectiveGopath otherwise.
+// The result is suitable for prepending to the executable search path.
+func GopathBin() (pathlist.List, error) {
+	if gobin := goenv("GOBIN"); gobin != "" {
+		if !filepath.IsAbs(gobin) {
+			return "", fmt.Errorf("env: GOBIN must be an absolute path: %q", gobin)
+		}
+		return pathlist.New(gobin)
+	}
+	gopath, err := EffectiveGopath()
+	if err != nil {
+		return "", err
+	}
+	var bins []string
+	for _, fp := range pathlist.Split(gopath) {
+		bins = append(bins, filepath.Join(fp, "bin"))
+	}
+	return pathlist.New(bins...)
+}
+
+// defaultGopath returns the go command's default GOPATH entry, if any.
+func defaultGopath() []string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return nil
+	}
+	def := filepath.Join(home, "go")
+	if !filepath.IsAbs(def) || filepath.Clean(def) == filepath.Clean(goenv("GOROOT")) {
+		return nil
+	}
+	return []string{def}
+}
+
+// goenv returns the value of the go command's configuration variable key,
+// looking it up in the environment first and then in the go environment file.
+func goenv(key string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	if val := goenvFile(key); val != "" {
+		return val
+	}
+	if key == "GOROOT" {
+		return runtime.GOROOT()
+	}
+	return ""
+}
+
+func goenvFile(key string) string {
+	file := os.Getenv("GOENV")
+	if file == "off" {
+		return ""
+	}
+	if file == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return ""
+		}
+		file = filepath.Join(dir, "go", "env")
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.IndexByte(line, '='); i > 0 && line[:i] == key {
+			return strings.TrimSuffix(line[i+1:], "\r")
+		}
+	}
+	return ""
+}
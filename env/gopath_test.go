@@ -0,0 +1,78 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env_test
+
+import (
+	"runtime"
+	"testing"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+var gopathTests = []struct {
+	gopath, gobin string
+	ok, binOK     bool
+	list, bin     pathlist.List
+}{
+	{gopath: "", ok: true, list: "/home/u/go", binOK: true, bin: "/home/u/go/bin"},
+	{gopath: "/w1", ok: true, list: "/w1", binOK: true, bin: "/w1/bin"},
+	{gopath: "/w1::/w2:", ok: true, list: "/w1:/w2", binOK: true, bin: "/w1/bin:/w2/bin"},
+	{gopath: "/goroot:/w1", ok: true, list: "/w1", binOK: true, bin: "/w1/bin"},
+	{gopath: "/goroot/", ok: true, list: "", binOK: true, bin: ""},
+	{gopath: "/w1:/w2", gobin: "/gobin", ok: true, list: "/w1:/w2", binOK: true, bin: "/gobin"},
+	{gopath: "/w1", gobin: "gobin", ok: true, list: "/w1", binOK: false},
+	{gopath: "/w1:w2", ok: false, binOK: false},
+	{gopath: "~/go", ok: false, binOK: false},
+}
+
+func TestEffectiveGopath(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	t.Setenv("GOENV", "off")
+	t.Setenv("GOROOT", "/goroot")
+	t.Setenv("HOME", "/home/u")
+	for _, tt := range gopathTests {
+		t.Setenv("GOPATH", tt.gopath)
+		t.Setenv("GOBIN", tt.gobin)
+		list, err := env.EffectiveGopath()
+		switch {
+		case tt.ok && (err != nil || list != tt.list):
+			t.Errorf("GOPATH=%q: EffectiveGopath() = %#q, %v; want %#q, nil",
+				tt.gopath, list, err, tt.list)
+		case !tt.ok && err == nil:
+			t.Errorf("GOPATH=%q: EffectiveGopath() = %#q, %v; want error",
+				tt.gopath, list, err)
+		default:
+			t.Logf("GOPATH=%q: EffectiveGopath() = %#q, %v", tt.gopath, list, err)
+		}
+		bin, err := env.GopathBin()
+		switch {
+		case tt.binOK && (err != nil || bin != tt.bin):
+			t.Errorf("GOPATH=%q GOBIN=%q: GopathBin() = %#q, %v; want %#q, nil",
+				tt.gopath, tt.gobin, bin, err, tt.bin)
+		case !tt.binOK && err == nil:
+			t.Errorf("GOPATH=%q GOBIN=%q: GopathBin() = %#q, %v; want error",
+				tt.gopath, tt.gobin, bin, err)
+		default:
+			t.Logf("GOPATH=%q GOBIN=%q: GopathBin() = %#q, %v", tt.gopath,
+				tt.gobin, bin, err)
+		}
+	}
+}
+
+func TestEffectiveGopathDefaultGoroot(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	t.Setenv("GOENV", "off")
+	t.Setenv("GOROOT", "/home/u/go")
+	t.Setenv("HOME", "/home/u")
+	t.Setenv("GOPATH", "")
+	if list, err := env.EffectiveGopath(); list != "" || err != nil {
+		t.Errorf("EffectiveGopath() = %#q, %v; want %#q, nil", list, err, "")
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env
+
+import (
+	"os"
+
+	"gopkg.in/pathlist.v0"
+)
+
+// Names of well-known variables holding filepath lists; see Known for the
+// ones recognized on the current OS.
+const (
+	VarLdLibraryPath   = "LD_LIBRARY_PATH"
+	VarDyldLibraryPath = "DYLD_LIBRARY_PATH"
+	VarManpath         = "MANPATH"
+	VarInfopath        = "INFOPATH"
+	VarPkgConfigPath   = "PKG_CONFIG_PATH"
+	VarPythonpath      = "PYTHONPATH"
+	VarNodePath        = "NODE_PATH"
+	VarClasspath       = "CLASSPATH"
+	VarCdpath          = "CDPATH"
+	VarXdgDataDirs     = "XDG_DATA_DIRS"
+	VarXdgConfigDirs   = "XDG_CONFIG_DIRS"
+	VarPerl5lib        = "PERL5LIB"
+	VarGemPath         = "GEM_PATH"
+)
+
+// Empty describes the meaning of empty elements in the value of a Var.
+type Empty int
+
+const (
+	// EmptyIgnored means that empty elements are skipped.
+	EmptyIgnored Empty = iota
+	// EmptyWorkdir means that empty elements refer to the working directory.
+	EmptyWorkdir
+	// EmptyDefault means that empty elements (including leading and
+	// trailing ones) are replaced by the default list, as in MANPATH.
+	EmptyDefault
+	// EmptyTrailingDefault means that a trailing empty element is replaced
+	// by the default list, as in INFOPATH.
+	EmptyTrailingDefault
+)
+
+// Var describes a well-known environment variable holding a filepath list.
+type Var struct {
+	// Name is the variable name on the current OS.
+	Name string
+	// Separator is the list separator expected by the consumers of the
+	// variable on the current OS, such as ';' on Windows and ':' elsewhere
+	// for the Java CLASSPATH.
+	Separator rune
+	// Empty is the meaning of empty elements in the value.
+	Empty Empty
+	// Default is the list assumed by consumers when the variable is unset,
+	// or the empty List if there is no fixed default.
+	Default pathlist.List
+	// DefaultIfEmpty means that Default is also assumed when the variable
+	// is set to the empty string, as for XDG_DATA_DIRS.
+	DefaultIfEmpty bool
+}
+
+// Known returns the description of the well-known variable name on the
+// current OS.
+func Known(name string) (Var, bool) {
+	for _, v := range knownVars {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return Var{}, false
+}
+
+// KnownVars returns the descriptions of all well-known variables on the
+// current OS.
+func KnownVars() []Var {
+	return append([]Var(nil), knownVars...)
+}
+
+// Get gets the value of v, or v.Default if v is unset (or empty, if
+// v.DefaultIfEmpty is set).
+func (v Var) Get() pathlist.List {
+	val, ok := os.LookupEnv(v.Name)
+	return v.value(val, ok)
+}
+
+// Set sets the value of v.
+func (v Var) Set(l pathlist.List) error {
+	return os.Setenv(v.Name, string(l))
+}
+
+// Slice gets the value of v from a slice of environment variables (as used
+// with os.Environ and os/exec.Cmd.Env), or v.Default if v is not present (or
+// empty, if v.DefaultIfEmpty is set).
+func (v Var) Slice(env []string) pathlist.List {
+	val, ok := SliceLookup(env)(v.Name)
+	return v.value(val, ok)
+}
+
+func (v Var) value(val string, ok bool) pathlist.List {
+	if !ok || (val == "" && v.DefaultIfEmpty) {
+		return v.Default
+	}
+	return pathlist.List(val)
+}
+
+// SetSlice returns a copy of env with v set to list; see SetSlice.
+func (v Var) SetSlice(env []string, list pathlist.List) []string {
+	return SetSlice(env, v.Name, list)
+}
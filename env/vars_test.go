@@ -0,0 +1,72 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env_test
+
+import (
+	"runtime"
+	"testing"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+func TestKnown(t *testing.T) {
+	for _, name := range []string{env.VarPath, env.VarGopath} {
+		v, ok := env.Known(name)
+		if !ok || v.Name != name || v.Separator != pathlist.ListSeparator {
+			t.Errorf("Known(%q) = %+v, %v; want Name %q, Separator %q", name, v, ok,
+				name, pathlist.ListSeparator)
+		}
+	}
+	if v, ok := env.Known(env.VarClasspath); ok {
+		want := ':'
+		if runtime.GOOS == "windows" {
+			want = ';'
+		}
+		if v.Separator != want {
+			t.Errorf("Known(%q).Separator = %q; want %q", env.VarClasspath,
+				v.Separator, want)
+		}
+	}
+	if v, ok := env.Known("PATHLIST_NONEXISTENT"); ok {
+		t.Errorf("Known(%q) = %+v, %v; want false", "PATHLIST_NONEXISTENT", v, ok)
+	}
+	for _, v := range env.KnownVars() {
+		if w, ok := env.Known(v.Name); !ok || w != v {
+			t.Errorf("Known(%q) = %+v, %v; want %+v, true", v.Name, w, ok, v)
+		}
+	}
+}
+
+func TestVarSlice(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	v, ok := env.Known(env.VarXdgDataDirs)
+	if !ok {
+		t.Fatalf("Known(%q) = _, false", env.VarXdgDataDirs)
+	}
+	tests := []struct {
+		env  []string
+		want pathlist.List
+	}{
+		{nil, v.Default},
+		{[]string{"XDG_DATA_DIRS="}, v.Default},
+		{[]string{"XDG_DATA_DIRS=/opt/share"}, "/opt/share"},
+	}
+	for _, tt := range tests {
+		if got := v.Slice(tt.env); got != tt.want {
+			t.Errorf("Var.Slice(%q) = %#q; want %#q", tt.env, got, tt.want)
+		}
+	}
+	t.Setenv(v.Name, "")
+	if got := v.Get(); got != v.Default {
+		t.Errorf("Var.Get() with %s empty = %#q; want %#q", v.Name, got, v.Default)
+	}
+	env2 := v.SetSlice(nil, "/opt/share")
+	if got := v.Slice(env2); got != "/opt/share" {
+		t.Errorf("Var.Slice(Var.SetSlice(nil, %#q)) = %#q", "/opt/share", got)
+	}
+}
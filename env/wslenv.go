@@ -0,0 +1,229 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/pathlist.v0"
+)
+
+// VarWslenv is the variable listing the variables shared between WSL and
+// Windows.
+const VarWslenv = "WSLENV"
+
+// WslenvVar is an entry of WSLENV: a variable name and its flags.
+type WslenvVar struct {
+	Name string
+	Path bool // flag p: the value is a single filepath to translate
+	List bool // flag l: the value is a filepath list to translate
+	// ToWSL (flag u) and ToWindows (flag w) limit sharing to when invoking
+	// WSL from Windows and Windows from WSL, respectively; the variable is
+	// shared both ways if neither or both are set.
+	ToWSL     bool
+	ToWindows bool
+}
+
+// String returns the WSLENV entry for v, such as "GOPATH/l".
+func (v WslenvVar) String() string {
+	flags := ""
+	for _, f := range []struct {
+		set  bool
+		flag string
+	}{{v.Path, "p"}, {v.List, "l"}, {v.ToWSL, "u"}, {v.ToWindows, "w"}} {
+		if f.set {
+			flags += f.flag
+		}
+	}
+	if flags == "" {
+		return v.Name
+	}
+	return v.Name + "/" + flags
+}
+
+// shared reports whether v is shared when invoking Windows from WSL
+// (toWindows) or WSL from Windows.
+func (v WslenvVar) shared(toWindows bool) bool {
+	if toWindows {
+		return v.ToWindows || !v.ToWSL
+	}
+	return v.ToWSL || !v.ToWindows
+}
+
+// Wslenv is the parsed value of WSLENV.
+type Wslenv []WslenvVar
+
+// ParseWslenv parses the value of WSLENV, a colon-separated list of variable
+// names, each optionally followed by '/' and flags.
+// Empty entries are skipped; an unknown flag is an error.
+func ParseWslenv(s string) (Wslenv, error) {
+	var w Wslenv
+	for _, e := range strings.Split(s, ":") {
+		if e == "" {
+			continue
+		}
+		v := WslenvVar{Name: e}
+		if i := strings.IndexByte(e, '/'); i >= 0 {
+			v.Name = e[:i]
+			for _, f := range e[i+1:] {
+				switch f {
+				case 'p':
+					v.Path = true
+				case 'l':
+					v.List = true
+				case 'u':
+					v.ToWSL = true
+				case 'w':
+					v.ToWindows = true
+				default:
+					return nil, fmt.Errorf("env: %s entry %q: unknown flag %q",
+						VarWslenv, e, f)
+				}
+			}
+		}
+		if v.Name == "" {
+			return nil, fmt.Errorf("env: %s entry %q: missing name", VarWslenv, e)
+		}
+		w = append(w, v)
+	}
+	return w, nil
+}
+
+// String returns the value of WSLENV for w.
+func (w Wslenv) String() string {
+	es := make([]string, len(w))
+	for i, v := range w {
+		es[i] = v.String()
+	}
+	return strings.Join(es, ":")
+}
+
+// Set returns a copy of w with the entry for v.Name replaced by v, or with v
+// appended if there is none.
+func (w Wslenv) Set(v WslenvVar) Wslenv {
+	w2 := append(Wslenv(nil), w...)
+	for i := range w2 {
+		if w2[i].Name == v.Name {
+			w2[i] = v
+			return w2
+		}
+	}
+	return append(w2, v)
+}
+
+// WslMappings returns the mappings of the Windows drives A: to Z: to their
+// mount points under root in WSL, such as "/mnt/" (the default automount
+// root).
+func WslMappings(root string) []pathlist.Mapping {
+	root = strings.TrimRight(root, "/")
+	ms := make([]pathlist.Mapping, 0, 26)
+	for c := 'a'; c <= 'z'; c++ {
+		ms = append(ms, pathlist.Mapping{
+			pathlist.Unix:    root + "/" + string(c),
+			pathlist.Windows: strings.ToUpper(string(c)) + `:\`,
+		})
+	}
+	return ms
+}
+
+// WslTranslator translates the values of variables flagged in WSLENV between
+// the WSL form (such as "/mnt/c/Go/bin") and the Windows form (such as
+// `C:\Go\bin`), using pathlist.Convert.
+// Filepaths outside the mapped prefixes cannot be translated; add a mapping
+// such as {pathlist.Unix: "/", pathlist.Windows: `\\wsl$\Ubuntu\`} to
+// translate them.
+type WslTranslator struct {
+	// Mappings are the prefix mappings; nil means WslMappings("/mnt/").
+	Mappings []pathlist.Mapping
+}
+
+func (t WslTranslator) mappings() []pathlist.Mapping {
+	if t.Mappings == nil {
+		return WslMappings("/mnt/")
+	}
+	return t.Mappings
+}
+
+// ToWindows translates list from WSL form to Windows form.
+// As with pathlist.Convert, entries that cannot be translated are omitted and
+// reported in a pathlist.Errors along with the list of the rest.
+func (t WslTranslator) ToWindows(list pathlist.List) (pathlist.List, error) {
+	return pathlist.Convert(list, pathlist.Unix, pathlist.Windows, t.mappings()...)
+}
+
+// ToWSL translates list from Windows form to WSL form.
+// As with pathlist.Convert, entries that cannot be translated are omitted and
+// reported in a pathlist.Errors along with the list of the rest.
+func (t WslTranslator) ToWSL(list pathlist.List) (pathlist.List, error) {
+	return pathlist.Convert(list, pathlist.Windows, pathlist.Unix, t.mappings()...)
+}
+
+// Path translates the single filepath fp from WSL form to Windows form
+// (toWindows) or the other way around.
+func (t WslTranslator) Path(fp string, toWindows bool) (string, error) {
+	from, to := pathlist.Windows, pathlist.Unix
+	if toWindows {
+		from, to = to, from
+	}
+	if err := pathlist.Validate(from, fp); err != nil {
+		return "", err
+	}
+	l := pathlist.List(fp)
+	if from == pathlist.Windows && strings.IndexByte(fp, ';') >= 0 {
+		l = pathlist.List(`"` + fp + `"`)
+	}
+	out, err := pathlist.Convert(l, from, to, t.mappings()...)
+	if err != nil {
+		return "", err
+	}
+	// quotes only appear around Windows filepaths containing ';'
+	return strings.Replace(string(out), `"`, "", -1), nil
+}
+
+// Translate takes a slice of environment variables (as used with os.Environ
+// and os/exec.Cmd.Env) of the side invoking the other one: WSL if toWindows
+// is set, Windows otherwise.
+// It returns a copy of env with the values of the variables shared in that
+// direction and flagged p or l in its WSLENV translated for the other side.
+// A variable whose value cannot be translated is left unchanged, and its
+// error is included in the returned error.
+// Variable names, including WSLENV, are matched case-insensitively in a
+// Windows env, so that Path also matches PATH.
+func (t WslTranslator) Translate(env []string, toWindows bool) ([]string, error) {
+	fold := !toWindows
+	wslenv, _ := lookupSlice(env, VarWslenv, fold)
+	w, err := ParseWslenv(wslenv)
+	if err != nil {
+		return env, err
+	}
+	var errs []error
+	for _, v := range w {
+		val, ok := lookupSlice(env, v.Name, fold)
+		if !ok || !v.shared(toWindows) || !(v.Path || v.List) {
+			continue
+		}
+		var out string
+		switch {
+		case v.List && toWindows:
+			var l pathlist.List
+			l, err = t.ToWindows(pathlist.List(val))
+			out = string(l)
+		case v.List:
+			var l pathlist.List
+			l, err = t.ToWSL(pathlist.List(val))
+			out = string(l)
+		default:
+			out, err = t.Path(val, toWindows)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("env: %s: %w", v.Name, err))
+			continue
+		}
+		env = setSlice(env, v.Name, pathlist.List(out), fold)
+	}
+	return env, errors.Join(errs...)
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package env_test
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+var wslenvTests = []struct {
+	s    string
+	want env.Wslenv
+	str  string
+}{
+	{"", nil, ""},
+	{"FOO", env.Wslenv{{Name: "FOO"}}, "FOO"},
+	{"GOPATH/l:USERPROFILE/pu::TMP/w", env.Wslenv{
+		{Name: "GOPATH", List: true},
+		{Name: "USERPROFILE", Path: true, ToWSL: true},
+		{Name: "TMP", ToWindows: true},
+	}, "GOPATH/l:USERPROFILE/pu:TMP/w"},
+	{"A/up", env.Wslenv{{Name: "A", Path: true, ToWSL: true}}, "A/pu"},
+}
+
+func TestParseWslenv(t *testing.T) {
+	for _, tt := range wslenvTests {
+		w, err := env.ParseWslenv(tt.s)
+		ok := err == nil && len(w) == len(tt.want) && w.String() == tt.str
+		for i := 0; ok && i < len(w); i++ {
+			ok = w[i] == tt.want[i]
+		}
+		if !ok {
+			t.Errorf("ParseWslenv(%q) = %+v, %v; want %+v, nil", tt.s, w, err, tt.want)
+		} else {
+			t.Logf("ParseWslenv(%q) = %+v", tt.s, w)
+		}
+	}
+	for _, s := range []string{"A/x", "/l"} {
+		if w, err := env.ParseWslenv(s); err == nil {
+			t.Errorf("ParseWslenv(%q) = %+v, nil; want error", s, w)
+		}
+	}
+}
+
+func TestWslenvSet(t *testing.T) {
+	w := env.Wslenv{{Name: "A"}, {Name: "B"}}
+	w2 := w.Set(env.WslenvVar{Name: "A", List: true}).Set(env.WslenvVar{Name: "C"})
+	if got, want := w2.String(), "A/l:B:C"; got != want || w.String() != "A:B" {
+		t.Errorf("Set() = %q (original %q); want %q (original %q)", got, w, want, "A:B")
+	}
+}
+
+func TestWslTranslator(t *testing.T) {
+	var tr env.WslTranslator
+	l, err := tr.ToWindows("/mnt/c/Go/bin:/mnt/d/x;y")
+	if want := pathlist.List(`C:\Go\bin;"D:\x;y"`); err != nil || l != want {
+		t.Errorf("ToWindows() = %#q, %v; want %#q, nil", l, err, want)
+	}
+	l, err = tr.ToWSL(`C:\Go\bin;"D:\x;y"`)
+	if want := pathlist.List("/mnt/c/Go/bin:/mnt/d/x;y"); err != nil || l != want {
+		t.Errorf("ToWSL() = %#q, %v; want %#q, nil", l, err, want)
+	}
+	for _, tt := range []struct {
+		fp        string
+		toWindows bool
+		want      string
+	}{
+		{"/mnt/c/Users/me", true, `C:\Users\me`},
+		{"/mnt/d/x;y", true, `D:\x;y`},
+		{`C:\Users\me`, false, "/mnt/c/Users/me"},
+		{`D:\x;y`, false, "/mnt/d/x;y"},
+	} {
+		if got, err := tr.Path(tt.fp, tt.toWindows); err != nil || got != tt.want {
+			t.Errorf("Path(%q, %v) = %q, %v; want %q, nil", tt.fp, tt.toWindows,
+				got, err, tt.want)
+		}
+	}
+	if got, err := tr.Path("/home/a:b", true); !errors.Is(err, pathlist.ErrSeparator) {
+		t.Errorf("Path(%q, true) = %q, %v; want ErrSeparator", "/home/a:b", got, err)
+	}
+}
+
+func TestWslTranslate(t *testing.T) {
+	tr := env.WslTranslator{Mappings: append(env.WslMappings("/mnt"),
+		pathlist.Mapping{pathlist.Unix: "/", pathlist.Windows: `\\wsl$\Ubuntu\`})}
+	in := []string{
+		"WSLENV=GOPATH/l:HOME/p:WINONLY/pu:PLAIN:MISSING/l",
+		"GOPATH=/home/me/go:/mnt/c/go",
+		"HOME=/home/me",
+		"WINONLY=/mnt/c/x",
+		"PLAIN=/mnt/c/y",
+	}
+	out, err := tr.Translate(in, true)
+	want := []string{
+		"WSLENV=GOPATH/l:HOME/p:WINONLY/pu:PLAIN:MISSING/l",
+		`GOPATH=\\wsl$\Ubuntu\home\me\go;C:\go`,
+		`HOME=\\wsl$\Ubuntu\home\me`,
+		"WINONLY=/mnt/c/x",
+		"PLAIN=/mnt/c/y",
+	}
+	if err != nil || !equalStrings(out, want) {
+		t.Errorf("Translate(%q, true) = %q, %v; want %q, nil", in, out, err, want)
+	}
+	if in[1] != "GOPATH=/home/me/go:/mnt/c/go" {
+		t.Errorf("Translate modified its argument: %q", in)
+	}
+
+	in = []string{"WSLENV=P/l", `P=E:\a:b;C:\c`}
+	out, err = tr.Translate(in, false)
+	if !errors.Is(err, pathlist.ErrSeparator) || !equalStrings(out, in) {
+		t.Errorf("Translate(%q, false) = %q, %v; want unchanged, ErrSeparator",
+			in, out, err)
+	}
+
+	// names are case-insensitive on the Windows side only
+	in = []string{"WslEnv=PATH/l", `Path=C:\Windows;D:\bin`}
+	out, err = tr.Translate(in, false)
+	want = []string{"WslEnv=PATH/l", "Path=/mnt/c/Windows:/mnt/d/bin"}
+	if err != nil || !equalStrings(out, want) {
+		t.Errorf("Translate(%q, false) = %q, %v; want %q, nil", in, out, err, want)
+	}
+	in = []string{"WSLENV=PATH/l", "Path=/mnt/c/Windows"}
+	out, err = tr.Translate(in, true)
+	if err != nil || !equalStrings(out, in) {
+		t.Errorf("Translate(%q, true) = %q, %v; want unchanged, nil", in, out, err)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package envfile parses the system files that set environment variables,
+// including filepath lists such as PATH, for Linux login sessions:
+// /etc/environment, /etc/security/pam_env.conf and the environment.d
+// directories of systemd.
+//
+// Parsed files are applied onto slices of environment variables (as used with
+// os.Environ, os/exec.Cmd.Env and env.SetSlice); use env.Slice or Lists to
+// extract the resulting lists.
+// Session applies all files in the order used for a login session.
+package envfile // import "gopkg.in/pathlist.v0/envfile"
+
+import (
+	"errors"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+// Assignment is a variable assignment read from /etc/environment or an
+// environment.d file.
+type Assignment struct {
+	Name  string
+	Value string // as written, after removing quotes
+}
+
+// Lists returns the values of the well-known filepath list variables (see
+// env.KnownVars) present in envs.
+func Lists(envs []string) map[string]pathlist.List {
+	lists := map[string]pathlist.List{}
+	for _, v := range env.KnownVars() {
+		if val, ok := lookup(envs, v.Name); ok {
+			lists[v.Name] = pathlist.List(val)
+		}
+	}
+	return lists
+}
+
+// Paths of the system files used by Session, relative to the file system
+// root.
+const (
+	EnvironmentFile = "etc/environment"
+	PamEnvFile      = "etc/security/pam_env.conf"
+)
+
+// EnvironmentDirs are the system environment.d directories relative to the
+// file system root, in decreasing order of priority.
+var EnvironmentDirs = []string{
+	"etc/environment.d",
+	"run/environment.d",
+	"usr/local/lib/environment.d",
+	"usr/lib/environment.d",
+}
+
+// Session returns envs with the system files in fsys (an fs.FS rooted at the
+// file system root, typically os.DirFS("/")) applied in the order used for a
+// login session: pam_env.conf, /etc/environment, and the environment.d
+// configuration (see ReadEnvironmentD), where configDir is the user's
+// configuration directory relative to the root (such as "home/user/.config"),
+// or empty to skip the user directory.
+// The @{HOME} and @{SHELL} references of pam_env.conf are resolved from envs.
+// Missing files are ignored.
+func Session(fsys fs.FS, configDir string, envs []string) ([]string, error) {
+	if data, err := readFile(fsys, PamEnvFile); err != nil {
+		return nil, err
+	} else if data != "" {
+		as, err := ParsePamEnv(strings.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		items := map[string]string{}
+		for _, item := range []string{"HOME", "SHELL"} {
+			items[item], _ = lookup(envs, item)
+		}
+		envs = ApplyPamEnv(envs, items, as)
+	}
+	if data, err := readFile(fsys, EnvironmentFile); err != nil {
+		return nil, err
+	} else if data != "" {
+		as, err := ParseEnvironment(strings.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		envs = ApplyEnvironment(envs, as)
+	}
+	as, err := ReadEnvironmentD(fsys, configDir)
+	if err != nil {
+		return nil, err
+	}
+	return ApplyEnvironmentD(envs, as), nil
+}
+
+// ReadEnvironmentD reads the *.conf files of the environment.d directories
+// in fsys, as done by systemd-environment-d-generator: the user directory
+// configDir/environment.d (if configDir is not empty) and EnvironmentDirs.
+// Files are processed in lexical order of their names; a file in a directory
+// of higher priority hides files with the same name in the other ones.
+func ReadEnvironmentD(fsys fs.FS, configDir string) ([]Assignment, error) {
+	dirs := EnvironmentDirs
+	if configDir != "" {
+		dirs = append([]string{path.Join(configDir, "environment.d")}, dirs...)
+	}
+	files := map[string]string{}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		entries, err := fs.ReadDir(fsys, dirs[i])
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if !e.IsDir() && strings.HasSuffix(e.Name(), ".conf") {
+				files[e.Name()] = path.Join(dirs[i], e.Name())
+			}
+		}
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var all []Assignment
+	for _, name := range names {
+		data, err := readFile(fsys, files[name])
+		if err != nil {
+			return nil, err
+		}
+		as, err := ParseEnvironmentD(strings.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, as...)
+	}
+	return all, nil
+}
+
+// readFile returns the contents of file, or "" if it does not exist.
+func readFile(fsys fs.FS, file string) (string, error) {
+	data, err := fs.ReadFile(fsys, file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	return string(data), err
+}
+
+func lookup(envs []string, key string) (string, bool) {
+	keyEq := key + "="
+	for _, kv := range envs {
+		if strings.HasPrefix(kv, keyEq) {
+			return kv[len(keyEq):], true
+		}
+	}
+	return "", false
+}
+
+// unset returns a copy of envs without key.
+func unset(envs []string, key string) []string {
+	keyEq := key + "="
+	res := make([]string, 0, len(envs))
+	for _, kv := range envs {
+		if !strings.HasPrefix(kv, keyEq) {
+			res = append(res, kv)
+		}
+	}
+	return res
+}
+
+// unquote removes a pair of matching single or double quotes around s.
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envfile
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+func TestParseEnvironment(t *testing.T) {
+	const data = `# comment
+PATH="/usr/local/bin:/usr/bin"
+export LANG=C
+  EDITOR = 'vi'
+not an assignment
+1BAD=x
+`
+	want := []Assignment{
+		{"PATH", "/usr/local/bin:/usr/bin"},
+		{"LANG", "C"},
+		{"EDITOR", "vi"},
+	}
+	got, err := ParseEnvironment(strings.NewReader(data))
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseEnvironment() = %q, %v; want %q, nil", got, err, want)
+	}
+}
+
+func TestParsePamEnv(t *testing.T) {
+	const data = `# comment
+PATH		DEFAULT=/usr/bin OVERRIDE=${PATH}
+MANPATH	DEFAULT="@{HOME}/man with space" \
+	OVERRIDE=
+EMPTY
+`
+	want := []PamAssignment{
+		{Name: "PATH", Default: "/usr/bin", Override: "${PATH}", HasDefault: true,
+			HasOverride: true},
+		{Name: "MANPATH", Default: "@{HOME}/man with space", HasDefault: true,
+			HasOverride: true},
+		{Name: "EMPTY"},
+	}
+	got, err := ParsePamEnv(strings.NewReader(data))
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParsePamEnv() = %+v, %v; want %+v, nil", got, err, want)
+	}
+}
+
+var applyPamEnvTests = []struct {
+	env  []string
+	a    PamAssignment
+	want []string
+}{
+	{nil, PamAssignment{Name: "V", Default: "d", HasDefault: true}, []string{"V=d"}},
+	{[]string{"V=x"}, PamAssignment{Name: "V", Default: "d", Override: "${V}:o",
+		HasDefault: true, HasOverride: true}, []string{"V=x:o"}},
+	{nil, PamAssignment{Name: "V", Default: "d", Override: "${V}",
+		HasDefault: true, HasOverride: true}, []string{"V=d"}},
+	{[]string{"V=x", "W=y"}, PamAssignment{Name: "V"}, []string{"W=y"}},
+	{nil, PamAssignment{Name: "V", Default: `@{HOME}/bin:\${V}`, HasDefault: true},
+		[]string{`V=/home/u/bin:${V}`}},
+}
+
+func TestApplyPamEnv(t *testing.T) {
+	items := map[string]string{"HOME": "/home/u"}
+	for _, tt := range applyPamEnvTests {
+		got := ApplyPamEnv(tt.env, items, []PamAssignment{tt.a})
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ApplyPamEnv(%q, %+v) = %q; want %q", tt.env, tt.a, got, tt.want)
+		}
+	}
+}
+
+var expandDTests = []struct {
+	s, want string
+}{
+	{"$A:${A}", "a:a"},
+	{"${B:-b}:${A:-x}", "b:a"},
+	{"${A:+x$A}:${B:+y}", "xa:"},
+	{`\$A$`, "$A$"},
+	{"${A", "${A"},
+	{"$A_B", ""},
+}
+
+func TestExpandD(t *testing.T) {
+	get := func(key string) string {
+		return map[string]string{"A": "a"}[key]
+	}
+	for _, tt := range expandDTests {
+		if got := expandD(tt.s, get); got != tt.want {
+			t.Errorf("expandD(%q) = %q; want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestSession(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	fsys := fstest.MapFS{
+		"etc/security/pam_env.conf": {Data: []byte(
+			"PATH DEFAULT=/bin\nMANPATH DEFAULT=@{HOME}/man\n")},
+		"etc/environment": {Data: []byte("PATH=/usr/bin:/bin\n")},
+		"usr/lib/environment.d/10-a.conf": {Data: []byte(
+			"PATH=/usr/lib/a:$PATH\n")},
+		"usr/lib/environment.d/20-b.conf": {Data: []byte("PATH=/hidden\n")},
+		"etc/environment.d/20-b.conf": {Data: []byte(
+			"PATH=/etc/b:${PATH}\n")},
+		"home/u/.config/environment.d/30-user.conf": {Data: []byte(
+			"PATH=${HOME}/bin:${PATH}\n")},
+	}
+	envs, err := Session(fsys, "home/u/.config", []string{"HOME=/home/u"})
+	if err != nil {
+		t.Fatalf("Session() = _, %v", err)
+	}
+	want := pathlist.List("/home/u/bin:/etc/b:/usr/lib/a:/usr/bin:/bin")
+	if got := env.Slice(envs, env.VarPath); got != want {
+		t.Errorf("Session(): PATH = %#q; want %#q", got, want)
+	}
+	lists := Lists(envs)
+	if lists[env.VarPath] != want || lists[env.VarManpath] != "/home/u/man" {
+		t.Errorf("Lists(Session()) = %q", lists)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envfile
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+// parseAssignments reads KEY=VALUE lines, skipping empty lines, comments and
+// lines that are not assignments.
+func parseAssignments(r io.Reader, export bool) ([]Assignment, error) {
+	var as []Assignment
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if export {
+			line = strings.TrimPrefix(line, "export ")
+		}
+		i := strings.IndexByte(line, '=')
+		if i <= 0 {
+			continue
+		}
+		name := strings.TrimSpace(line[:i])
+		if !validName(name) {
+			continue
+		}
+		val := strings.TrimSpace(line[i+1:])
+		as = append(as, Assignment{Name: name, Value: unquote(val)})
+	}
+	return as, s.Err()
+}
+
+// ParseEnvironment parses the /etc/environment format read by pam_env:
+// KEY=VALUE lines, optionally prefixed with "export", with values optionally
+// quoted and not subject to expansion.
+// Like pam_env, it ignores lines that are not assignments.
+func ParseEnvironment(r io.Reader) ([]Assignment, error) {
+	return parseAssignments(r, true)
+}
+
+// ApplyEnvironment returns a copy of envs with the assignments from
+// /etc/environment applied.
+func ApplyEnvironment(envs []string, as []Assignment) []string {
+	for _, a := range as {
+		envs = env.SetSlice(envs, a.Name, pathlist.List(a.Value))
+	}
+	return envs
+}
+
+// ParseEnvironmentD parses the environment.d format of systemd: KEY=VALUE
+// lines, with values optionally quoted and subject to expansion of $VAR,
+// ${VAR}, ${VAR:-default} and ${VAR:+alternate} when applied.
+// Lines that are not assignments are ignored.
+func ParseEnvironmentD(r io.Reader) ([]Assignment, error) {
+	return parseAssignments(r, false)
+}
+
+// ApplyEnvironmentD returns a copy of envs with the assignments from
+// environment.d files applied in order, expanding references to variables
+// in envs and earlier assignments.
+func ApplyEnvironmentD(envs []string, as []Assignment) []string {
+	for _, a := range as {
+		val := expandD(a.Value, func(key string) string {
+			val, _ := lookup(envs, key)
+			return val
+		})
+		envs = env.SetSlice(envs, a.Name, pathlist.List(val))
+	}
+	return envs
+}
+
+// expandD expands variable references in s as systemd does for
+// environment.d.
+func expandD(s string, get func(string) string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case c == '$' && i+1 < len(s) && s[i+1] == '{':
+			end := closingBrace(s, i+2)
+			if end < 0 {
+				b.WriteString(s[i:])
+				return b.String()
+			}
+			b.WriteString(expandBraced(s[i+2:end], get))
+			i = end
+		case c == '$':
+			n := nameLen(s[i+1:])
+			if n == 0 {
+				b.WriteByte(c)
+				continue
+			}
+			b.WriteString(get(s[i+1 : i+1+n]))
+			i += n
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// expandBraced expands the contents of a ${...} reference.
+func expandBraced(ref string, get func(string) string) string {
+	n := nameLen(ref)
+	name, op := ref[:n], ref[n:]
+	val := get(name)
+	switch {
+	case strings.HasPrefix(op, ":-"):
+		if val == "" {
+			return expandD(op[2:], get)
+		}
+	case strings.HasPrefix(op, ":+"):
+		if val != "" {
+			return expandD(op[2:], get)
+		}
+		return ""
+	}
+	return val
+}
+
+// closingBrace returns the index of the brace closing a reference whose
+// contents start at s[i:], or -1.
+func closingBrace(s string, i int) int {
+	depth := 1
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// nameLen returns the length of the variable name at the start of s.
+func nameLen(s string) int {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' || 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' ||
+			i > 0 && '0' <= c && c <= '9' {
+			continue
+		}
+		return i
+	}
+	return len(s)
+}
+
+func validName(name string) bool {
+	return name != "" && nameLen(name) == len(name)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package envfile
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+// PamAssignment is a variable definition read from pam_env.conf.
+type PamAssignment struct {
+	Name string
+	// Default and Override are the DEFAULT and OVERRIDE values as written,
+	// after removing quotes; HasDefault and HasOverride report whether they
+	// were present.
+	Default, Override       string
+	HasDefault, HasOverride bool
+}
+
+// ParsePamEnv parses the pam_env.conf format:
+//
+//	VARIABLE [DEFAULT=[value]] [OVERRIDE=[value]]
+//
+// with values optionally double-quoted, lines continued with a trailing
+// backslash, and ${VAR} and @{ITEM} references expanded when applied.
+// Like pam_env, it ignores malformed lines and unknown options.
+func ParsePamEnv(r io.Reader) ([]PamAssignment, error) {
+	var as []PamAssignment
+	s := bufio.NewScanner(r)
+	line := ""
+	for s.Scan() {
+		l := s.Text()
+		if strings.HasSuffix(l, `\`) {
+			line += l[:len(l)-1]
+			continue
+		}
+		l, line = line+l, ""
+		fields := pamFields(l)
+		if len(fields) == 0 || fields[0][0] == '#' || !validName(fields[0]) {
+			continue
+		}
+		a := PamAssignment{Name: fields[0]}
+		for _, f := range fields[1:] {
+			switch {
+			case strings.HasPrefix(f, "DEFAULT="):
+				a.Default, a.HasDefault = unquote(f[len("DEFAULT="):]), true
+			case strings.HasPrefix(f, "OVERRIDE="):
+				a.Override, a.HasOverride = unquote(f[len("OVERRIDE="):]), true
+			}
+		}
+		as = append(as, a)
+	}
+	return as, s.Err()
+}
+
+// pamFields splits line at whitespace outside double quotes.
+func pamFields(line string) []string {
+	var fields []string
+	start, quoted := -1, false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case (c == ' ' || c == '\t') && !quoted:
+			if start >= 0 {
+				fields = append(fields, line[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, line[start:])
+	}
+	return fields
+}
+
+// ApplyPamEnv returns a copy of envs with the definitions from pam_env.conf
+// applied in order.
+// As in pam_env, the OVERRIDE value is used if it expands to a non-empty
+// string and the DEFAULT value otherwise; the variable is removed if both
+// are empty.
+// ${VAR} references are resolved from envs (including earlier definitions),
+// and @{ITEM} references from items (such as HOME and SHELL).
+func ApplyPamEnv(envs []string, items map[string]string, as []PamAssignment) []string {
+	get := func(key string) string {
+		val, _ := lookup(envs, key)
+		return val
+	}
+	for _, a := range as {
+		val := ""
+		if a.HasOverride {
+			val = expandPam(a.Override, get, items)
+		}
+		if val == "" && a.HasDefault {
+			val = expandPam(a.Default, get, items)
+		}
+		if val == "" {
+			envs = unset(envs, a.Name)
+			continue
+		}
+		envs = env.SetSlice(envs, a.Name, pathlist.List(val))
+	}
+	return envs
+}
+
+// expandPam expands ${VAR} and @{ITEM} references in s; "\$" and "\@"
+// stand for literal characters.
+func expandPam(s string, get func(string) string, items map[string]string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && (s[i+1] == '$' || s[i+1] == '@'):
+			i++
+			b.WriteByte(s[i])
+		case (c == '$' || c == '@') && i+1 < len(s) && s[i+1] == '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				b.WriteString(s[i:])
+				return b.String()
+			}
+			name := s[i+2 : i+2+end]
+			if c == '$' {
+				b.WriteString(get(name))
+			} else {
+				b.WriteString(items[name])
+			}
+			i += 2 + end
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
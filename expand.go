@@ -0,0 +1,161 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"fmt"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ExpandError reports an unresolved reference in a list entry.
+type ExpandError struct {
+	Entry string // the entry containing the reference
+	Ref   string // the reference, such as "$VAR", "%VAR%" or "~user"
+}
+
+func (e *ExpandError) Error() string {
+	return fmt.Sprintf("pathlist: unresolved reference %s; entry: %#q", e.Ref, e.Entry)
+}
+
+// Expand returns list with references expanded in each entry: a leading ~ or
+// ~user, $VAR and ${VAR}, and on Windows also %VAR%.
+// Variables are resolved using lookup, such as os.LookupEnv or a function
+// returned by env.SliceLookup; ~ is resolved as the home directory variable of
+// the OS (HOME, USERPROFILE on Windows, home on Plan 9) and ~user using
+// os/user.
+// An entry consisting of a single variable reference is replaced by the
+// entries of the variable's value as a List, so that "$GOPATH" may expand to
+// multiple entries; elsewhere a value is substituted as a single filepath.
+// An *ExpandError is returned for an unresolved reference, and an Error if an
+// expanded filepath is invalid.
+func Expand(list List, lookup func(key string) (string, bool)) (List, error) {
+	return expand(list, lookup, runtime.GOOS == "windows")
+}
+
+func expand(list List, lookup func(string) (string, bool), percent bool) (List, error) {
+	var fps []string
+	for _, entry := range Split(list) {
+		if name, ok := soleRef(entry, percent); ok {
+			val, ok := lookup(name)
+			if !ok {
+				return "", &ExpandError{Entry: entry, Ref: entry}
+			}
+			fps = append(fps, Split(List(val))...)
+			continue
+		}
+		fp, err := expandEntry(entry, lookup, percent)
+		if err != nil {
+			return "", err
+		}
+		fps = append(fps, fp)
+	}
+	return New(fps...)
+}
+
+// soleRef returns the variable name if entry consists of a single variable
+// reference.
+func soleRef(entry string, percent bool) (string, bool) {
+	switch {
+	case strings.HasPrefix(entry, "${") && strings.HasSuffix(entry, "}"):
+		name := entry[2 : len(entry)-1]
+		return name, name != "" && varNameLen(name) == len(name)
+	case strings.HasPrefix(entry, "$"):
+		name := entry[1:]
+		return name, name != "" && varNameLen(name) == len(name)
+	case percent && len(entry) > 2 && entry[0] == '%' && entry[len(entry)-1] == '%':
+		name := entry[1 : len(entry)-1]
+		return name, !strings.Contains(name, "%")
+	}
+	return "", false
+}
+
+// tildeEnd holds the characters terminating a leading ~user.
+var tildeEnd = "/" + string(filepath.Separator)
+
+func expandEntry(entry string, lookup func(string) (string, bool), percent bool) (string, error) {
+	var b strings.Builder
+	s := entry
+	if strings.HasPrefix(s, "~") {
+		end := strings.IndexAny(s, tildeEnd)
+		if end < 0 {
+			end = len(s)
+		}
+		home, ok := homeDir(s[1:end], lookup)
+		if !ok {
+			return "", &ExpandError{Entry: entry, Ref: s[:end]}
+		}
+		b.WriteString(home)
+		s = s[end:]
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		var name, ref string
+		switch {
+		case c == '$' && strings.HasPrefix(s[i+1:], "{"):
+			end := strings.IndexByte(s[i:], '}')
+			if end < 0 {
+				b.WriteByte(c)
+				continue
+			}
+			name, ref = s[i+2:i+end], s[i:i+end+1]
+		case c == '$' && varNameLen(s[i+1:]) > 0:
+			n := varNameLen(s[i+1:])
+			name, ref = s[i+1:i+1+n], s[i:i+1+n]
+		case c == '%' && percent:
+			end := strings.IndexByte(s[i+1:], '%')
+			if end <= 0 {
+				b.WriteByte(c)
+				continue
+			}
+			name, ref = s[i+1:i+1+end], s[i:i+end+2]
+		default:
+			b.WriteByte(c)
+			continue
+		}
+		val, ok := lookup(name)
+		if !ok {
+			return "", &ExpandError{Entry: entry, Ref: ref}
+		}
+		b.WriteString(val)
+		i += len(ref) - 1
+	}
+	return b.String(), nil
+}
+
+// homeDir returns the home directory of the named user, or of the current
+// user (as given by the OS-specific variable) if name is empty.
+func homeDir(name string, lookup func(string) (string, bool)) (string, bool) {
+	if name == "" {
+		key := "HOME"
+		switch runtime.GOOS {
+		case "windows":
+			key = "USERPROFILE"
+		case "plan9":
+			key = "home"
+		}
+		return lookup(key)
+	}
+	u, err := user.Lookup(name)
+	if err != nil {
+		return "", false
+	}
+	return u.HomeDir, true
+}
+
+// varNameLen returns the length of the variable name at the start of s.
+func varNameLen(s string) int {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' || 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' ||
+			i > 0 && '0' <= c && c <= '9' {
+			continue
+		}
+		return i
+	}
+	return len(s)
+}
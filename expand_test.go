@@ -0,0 +1,78 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"runtime"
+	"testing"
+)
+
+var expandVars = map[string]string{
+	"HOME":        "/home/u",
+	"USERPROFILE": "/home/u",
+	"home":        "/home/u",
+	"GOPATH":      string(colonToSep("/w1:/w2")),
+	"A":           "a",
+	"EMPTY":       "",
+}
+
+func expandLookup(key string) (string, bool) {
+	val, ok := expandVars[key]
+	return val, ok
+}
+
+var expandTests = []struct {
+	list    List
+	percent bool
+	ok      bool
+	want    List
+}{
+	{"", false, true, ""},
+	{":", false, true, ":"},
+	{"/bin", false, true, "/bin"},
+	{"~:~/bin", false, true, "/home/u:/home/u/bin"},
+	{"a~/b", false, true, "a~/b"},
+	{"$HOME/bin:${HOME}/.cargo/bin", false, true, "/home/u/bin:/home/u/.cargo/bin"},
+	{"/x/$A$A/${A}b/$", false, true, "/x/aa/ab/$"},
+	{"/bin:$GOPATH:/sbin", false, true, "/bin:/w1:/w2:/sbin"},
+	{"${GOPATH}", false, true, "/w1:/w2"},
+	{"$EMPTY", false, true, ""},
+	{"%A%/bin:%GOPATH%", true, true, "a/bin:/w1:/w2"},
+	{"%A%/bin", false, true, "%A%/bin"},
+	{"50%:100%", true, true, "50%:100%"},
+	{"$NOPE/bin", false, false, ""},
+	{"${NOPE}", false, false, ""},
+	{"%NOPE%", true, false, ""},
+	{"~pathlist-nonexistent-user/bin", false, false, ""},
+}
+
+func TestExpand(t *testing.T) {
+	if runtime.GOOS == "plan9" {
+		t.Skip("test lists contain ':'")
+	}
+	for _, tt := range expandTests {
+		list := colonToSep(tt.list)
+		got, err := expand(list, expandLookup, tt.percent)
+		want := colonToSep(tt.want)
+		switch {
+		case tt.ok && (err != nil || got != want):
+			t.Errorf("expand(%#q, %v) = %#q, %v; want %#q, nil", list, tt.percent,
+				got, err, want)
+		case !tt.ok && err == nil:
+			t.Errorf("expand(%#q, %v) = %#q, nil; want error", list, tt.percent, got)
+		default:
+			t.Logf("expand(%#q, %v) = %#q, %v", list, tt.percent, got, err)
+		}
+	}
+}
+
+func TestExpandError(t *testing.T) {
+	list := colonToSep("/a:$NOPE/bin")
+	_, err := expand(list, expandLookup, false)
+	ee, ok := err.(*ExpandError)
+	if !ok || ee.Ref != "$NOPE" || ee.Entry != "$NOPE/bin" {
+		t.Errorf("expand(%#q) error = %#v; want *ExpandError for %q", list, err, "$NOPE")
+	}
+}
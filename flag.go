@@ -0,0 +1,45 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+// Flag is a command-line flag value holding a List, implementing flag.Value
+// and flag.Getter.
+// Each occurrence of the flag is split using Split and the resulting
+// filepaths are appended using AppendTo, so that both repeated flags
+// (-I dir1 -I dir2) and lists (-path dir1:dir2) are accepted.
+// An initial value of List serves as the default: it is replaced by the
+// filepaths of the first occurrence, and later occurrences append to those.
+type Flag struct {
+	List List
+	set  bool
+}
+
+// String implements flag.Value.
+func (f *Flag) String() string {
+	if f == nil {
+		return ""
+	}
+	return string(f.List)
+}
+
+// Set implements flag.Value, returning an Error if there is an invalid
+// filepath in s.
+func (f *Flag) Set(s string) error {
+	l := f.List
+	if !f.set {
+		l = ""
+	}
+	l, err := AppendTo(l, Split(List(s))...)
+	if err != nil {
+		return err
+	}
+	f.List, f.set = l, true
+	return nil
+}
+
+// Get implements flag.Getter, returning the List.
+func (f *Flag) Get() interface{} {
+	return f.List
+}
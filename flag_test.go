@@ -0,0 +1,48 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"flag"
+	"io/ioutil"
+	"testing"
+)
+
+var _ flag.Getter = (*Flag)(nil)
+
+var flagTests = []struct {
+	init List
+	args []string
+	want List
+}{
+	{"", []string{}, ""},
+	{"", []string{"-I", "a"}, "a"},
+	{"", []string{"-I", "a", "-I", "b"}, "a:b"},
+	{"", []string{"-I", "a:b", "-I", "c"}, "a:b:c"},
+	{"x", []string{"-I", "a"}, "a"},
+	{"x", []string{"-I", "a", "-I", "b:c"}, "a:b:c"},
+	{"x", []string{}, "x"},
+}
+
+func TestFlag(t *testing.T) {
+	for _, tt := range flagTests {
+		f := Flag{List: colonToSep(tt.init)}
+		args := make([]string, len(tt.args))
+		for i, a := range tt.args {
+			args[i] = string(colonToSep(List(a)))
+		}
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(ioutil.Discard)
+		fs.Var(&f, "I", "include directory")
+		err := fs.Parse(args)
+		want := colonToSep(tt.want)
+		if err != nil || f.List != want || f.Get() != want {
+			t.Errorf("Parse(%q) with initial %#q: %#q, %v; want %#q, nil",
+				args, tt.init, f.List, err, want)
+		} else {
+			t.Logf("Parse(%q) with initial %#q: %#q", args, tt.init, f.List)
+		}
+	}
+}
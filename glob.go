@@ -0,0 +1,193 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// GlobSort specifies the order of the directories matching a pattern in Glob.
+type GlobSort int
+
+const (
+	// SortLexical orders matches lexically.
+	SortLexical GlobSort = iota
+	// SortVersion orders matches by comparing runs of digits numerically, so
+	// that go1.9 precedes go1.10, and a match extended by a prerelease
+	// suffix (such as 1.2.0-rc1 or go1.21rc1) precedes the match without
+	// it, while one extended by another suffix (such as jdk8u292) follows it.
+	SortVersion
+	// SortModTime orders matches by modification time.
+	SortModTime
+)
+
+// GlobOptions holds the options for Glob.
+type GlobOptions struct {
+	// FS is the file system to match patterns in; if nil, patterns are
+	// matched in the OS file system using filepath.Glob.
+	// With FS, patterns use the syntax of fs.Glob, and matches are joined
+	// to Root.
+	FS   fs.FS
+	Root string
+	// Sort is the order of the matches of each pattern, oldest first, unless
+	// Descending is set.
+	Sort       GlobSort
+	Descending bool
+	// NewestOnly keeps only the last match of each pattern in Sort order.
+	NewestOnly bool
+}
+
+// Glob returns a List of the directories matching patterns, such as
+// /opt/*/bin, in the order of the patterns, with the matches of each pattern
+// ordered as specified by opts.
+// Matches that are not directories are omitted.
+// An Error is returned if a match cannot be part of a List.
+func Glob(opts GlobOptions, patterns ...string) (List, error) {
+	var fps []string
+	for _, pattern := range patterns {
+		var matches []string
+		var err error
+		if opts.FS != nil {
+			matches, err = fs.Glob(opts.FS, pattern)
+		} else {
+			matches, err = filepath.Glob(pattern)
+		}
+		if err != nil {
+			return "", err
+		}
+		dirs, mtimes := matches[:0], map[string]time.Time{}
+		for _, m := range matches {
+			var fi fs.FileInfo
+			if opts.FS != nil {
+				fi, err = fs.Stat(opts.FS, m)
+			} else {
+				fi, err = os.Stat(m)
+			}
+			if err != nil || !fi.IsDir() {
+				continue
+			}
+			dirs = append(dirs, m)
+			mtimes[m] = fi.ModTime()
+		}
+		sortGlob(dirs, mtimes, opts.Sort)
+		if opts.NewestOnly && len(dirs) > 0 {
+			dirs = dirs[len(dirs)-1:]
+		}
+		if opts.Descending {
+			for i, j := 0, len(dirs)-1; i < j; i, j = i+1, j-1 {
+				dirs[i], dirs[j] = dirs[j], dirs[i]
+			}
+		}
+		for _, d := range dirs {
+			if opts.FS != nil {
+				d = filepath.Join(opts.Root, filepath.FromSlash(path.Clean(d)))
+			}
+			fps = append(fps, d)
+		}
+	}
+	return New(fps...)
+}
+
+func sortGlob(dirs []string, mtimes map[string]time.Time, by GlobSort) {
+	sort.SliceStable(dirs, func(i, j int) bool {
+		switch by {
+		case SortVersion:
+			return compareVersion(dirs[i], dirs[j]) < 0
+		case SortModTime:
+			return mtimes[dirs[i]].Before(mtimes[dirs[j]])
+		}
+		return dirs[i] < dirs[j]
+	})
+}
+
+// compareVersion compares a and b, treating runs of digits as numbers.
+// A '-' (as in semantic versioning) or one of the tags alpha, beta, pre and rc
+// following a number starts a prerelease suffix, which sorts before the end of
+// the string and before anything else, so that go1.21rc2 < go1.21 < go1.21.0.
+// Other suffixes, such as the update in jdk8u292 or the build metadata in
+// 1.2.3+build, sort after the end of the string.
+func compareVersion(a, b string) int {
+	afterNum := false
+	for a != "" && b != "" {
+		da, db := digitsLen(a), digitsLen(b)
+		switch {
+		case da > 0 && db > 0:
+			na, nb := trimZeros(a[:da]), trimZeros(b[:db])
+			if len(na) != len(nb) {
+				return cmpInt(len(na), len(nb))
+			}
+			if na != nb {
+				return strings.Compare(na, nb)
+			}
+			a, b = a[da:], b[db:]
+			afterNum = true
+			continue
+		case a[0] != b[0]:
+			if ra, rb := suffixRank(a), suffixRank(b); afterNum && ra != rb {
+				return cmpInt(ra, rb)
+			}
+			return strings.Compare(a[:1], b[:1])
+		}
+		a, b = a[1:], b[1:]
+		afterNum = false
+	}
+	if a == b {
+		return 0
+	}
+	if afterNum {
+		return cmpInt(suffixRank(a), suffixRank(b))
+	}
+	return cmpInt(len(a), len(b))
+}
+
+// suffixRank ranks what follows a number in a version: a prerelease suffix,
+// the end, or anything else.
+func suffixRank(s string) int {
+	if s == "" {
+		return 1
+	}
+	if s[0] == '-' {
+		return 0
+	}
+	for _, tag := range prereleaseTags {
+		if len(s) >= len(tag) && strings.EqualFold(s[:len(tag)], tag) {
+			return 0
+		}
+	}
+	return 2
+}
+
+var prereleaseTags = []string{"alpha", "beta", "pre", "rc"}
+
+func digitsLen(s string) int {
+	i := 0
+	for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+func trimZeros(s string) string {
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+	return s
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
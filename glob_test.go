@@ -0,0 +1,124 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+var compareVersionTests = []struct {
+	a, b string
+	want int
+}{
+	{"go1.9", "go1.10", -1},
+	{"go1.10", "go1.9", 1},
+	{"v1.2.0", "v1.2.0", 0},
+	{"v1.2.0-rc1", "v1.2.0", -1},
+	{"v1.2.0", "v1.2.0-rc1", 1},
+	{"v1.2.0-rc1", "v1.2.0-rc2", -1},
+	{"jdk-011", "jdk-11", 0},
+	{"a", "b", -1},
+	{"1.2", "1.2.1", -1},
+	{"go1.21rc2", "go1.21.0", -1},
+	{"go1.21.0", "go1.21rc2", 1},
+	{"go1.21rc2", "go1.21", -1},
+	{"go1.21beta1", "go1.21rc1", -1},
+	{"go1.21rc1", "go1.21rc2", -1},
+	{"go1.20.5", "go1.21rc1", -1},
+	{"jdk8", "jdk8u292", -1},
+	{"jdk8u292", "jdk8", 1},
+	{"jdk8u292", "jdk8u302", -1},
+	{"1.2.3-rc1", "1.2.3", -1},
+	{"1.2.3", "1.2.3-rc1", 1},
+	{"1.2.3", "1.2.3+build", -1},
+	{"1.2.3rc1", "1.2.3p1", -1},
+	{"1.2.3alpha", "1.2.3beta", -1},
+}
+
+func TestCompareVersion(t *testing.T) {
+	for _, tt := range compareVersionTests {
+		if got := compareVersion(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersion(%q, %q) = %d; want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func globFS() fstest.MapFS {
+	now := time.Now()
+	return fstest.MapFS{
+		"sdk/go1.9/bin":       {Mode: os.ModeDir, ModTime: now.Add(-1 * time.Hour)},
+		"sdk/go1.10/bin":      {Mode: os.ModeDir, ModTime: now.Add(-3 * time.Hour)},
+		"sdk/go1.11-rc1/bin":  {Mode: os.ModeDir, ModTime: now.Add(-2 * time.Hour)},
+		"sdk/notadir/bin":     {Data: []byte("file")},
+		"opt/tool/bin":        {Mode: os.ModeDir},
+		"opt/tool/bin/binary": {Data: []byte("file")},
+	}
+}
+
+var globTests = []struct {
+	opts     GlobOptions
+	patterns []string
+	want     []string
+}{
+	{GlobOptions{}, []string{"sdk/*/bin"},
+		[]string{"/r/sdk/go1.10/bin", "/r/sdk/go1.11-rc1/bin", "/r/sdk/go1.9/bin"}},
+	{GlobOptions{Sort: SortVersion}, []string{"sdk/*/bin"},
+		[]string{"/r/sdk/go1.9/bin", "/r/sdk/go1.10/bin", "/r/sdk/go1.11-rc1/bin"}},
+	{GlobOptions{Sort: SortVersion, Descending: true}, []string{"sdk/*/bin", "opt/*/bin"},
+		[]string{"/r/sdk/go1.11-rc1/bin", "/r/sdk/go1.10/bin", "/r/sdk/go1.9/bin",
+			"/r/opt/tool/bin"}},
+	{GlobOptions{Sort: SortModTime}, []string{"sdk/*/bin"},
+		[]string{"/r/sdk/go1.10/bin", "/r/sdk/go1.11-rc1/bin", "/r/sdk/go1.9/bin"}},
+	{GlobOptions{Sort: SortModTime, NewestOnly: true}, []string{"sdk/*/bin"},
+		[]string{"/r/sdk/go1.9/bin"}},
+	{GlobOptions{Sort: SortVersion, NewestOnly: true}, []string{"sdk/*/bin", "none/*"},
+		[]string{"/r/sdk/go1.11-rc1/bin"}},
+}
+
+func TestGlobFS(t *testing.T) {
+	for _, tt := range globTests {
+		opts := tt.opts
+		opts.FS, opts.Root = globFS(), filepath.FromSlash("/r")
+		want := make([]string, len(tt.want))
+		for i, w := range tt.want {
+			want[i] = filepath.FromSlash(w)
+		}
+		got, err := Glob(opts, tt.patterns...)
+		if err != nil || !equiv(Split(got), want) {
+			t.Errorf("Glob(%+v, %q) = %#q, %v; want %q, nil", tt.opts, tt.patterns,
+				got, err, want)
+		} else {
+			t.Logf("Glob(%+v, %q) = %#q", tt.opts, tt.patterns, got)
+		}
+	}
+}
+
+func TestGlob(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pathlist_test.TestGlob_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, d := range []string{"a/bin", "b/bin"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0777); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []string{filepath.Join(dir, "a", "bin"), filepath.Join(dir, "b", "bin")}
+	got, err := Glob(GlobOptions{}, filepath.Join(dir, "*", "bin"))
+	if err != nil || strings.Join(Split(got), "\n") != strings.Join(want, "\n") {
+		t.Errorf("Glob(%q) = %#q, %v; want %q, nil", filepath.Join(dir, "*", "bin"),
+			got, err, want)
+	}
+	if _, err := Glob(GlobOptions{}, "["); err == nil {
+		t.Errorf("Glob(%q) = _, nil; want error", "[")
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"strings"
+)
+
+// List handling for any dialect, independent of the OS.
+
+// Separator returns the list separator of dialect d, which must be valid (see
+// CheckDialect).
+func Separator(d Dialect) byte {
+	switch d {
+	case Unix:
+		return ':'
+	case Windows:
+		return ';'
+	}
+	return 0
+}
+
+// PathSeparators returns the filepath separators of dialect d, the preferred
+// one first.
+func PathSeparators(d Dialect) string {
+	if d == Windows {
+		return `\/`
+	}
+	return "/"
+}
+
+// FilepathsDialect is like Filepaths, for a list of dialect d.
+func FilepathsDialect(d Dialect, l string) []string {
+	sep := string(Separator(d))
+	switch l {
+	case "":
+		return []string{}
+	case sep:
+		return []string{""}
+	}
+	if d != Windows {
+		return strings.Split(l, sep)
+	}
+	es := SplitElemsWindows(l)
+	for i, e := range es {
+		es[i] = UnquoteWindows(e)
+	}
+	return es
+}
+
+// NewElemDialect is like NewElem, for a list of dialect d, validating fp as
+// ValidateElem does.
+func NewElemDialect(d Dialect, fp string) (string, error) {
+	if err := ValidateElem(d, fp); err != nil {
+		return "", err
+	}
+	if d == Windows && strings.IndexByte(fp, ';') >= 0 {
+		return `"` + fp + `"`, nil
+	}
+	return fp, nil
+}
+
+// NewListDialect is like NewList, for a list of dialect d.
+func NewListDialect(d Dialect, e ...string) string {
+	sep := string(Separator(d))
+	if len(e) == 1 && e[0] == "" {
+		return sep
+	}
+	return strings.Join(e, sep)
+}
@@ -12,10 +12,15 @@
 //  e:  elem (single, potentially quoted)
 //  el: e or l
 //
-// TODO(speter): sane behavior on elems containing unclosed quotes
+// On Windows, a quote left unclosed in a list extends to the end of the list,
+// as in filepath.SplitList. Append and Prepend preserve this interpretation by
+// closing such a quote at the end of the list (see CloseQuote); the pathlist
+// package also provides strict functions rejecting such lists, and a repair
+// function removing the unclosed quote (see RepairQuotes).
 package internal
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -26,8 +31,89 @@ import (
 const (
 	ErrSep   = "filepath must not contain ListSeparator" // Unix only
 	ErrQuote = "filepath must not be quoted"             // Windows only
+	ErrNul   = "filepath must not contain NUL"           // Validate only
+	ErrUnmap = "filepath has no prefix mapping"          // Convert only
 )
 
+var (
+	ErrSeparator = errors.New(ErrSep)
+	ErrQuoted    = errors.New(ErrQuote)
+	ErrUnclosed  = errors.New(ErrUnclosedQuote)
+	ErrNulByte   = errors.New(ErrNul)
+	ErrUnmapped  = errors.New(ErrUnmap)
+	ErrDialect   = errors.New("pathlist: invalid dialect")
+)
+
+type Cause int
+
+const (
+	CauseSep Cause = iota + 1
+	CauseQuote
+	CauseNul
+	CauseUnmap
+)
+
+func (c Cause) String() string {
+	switch c {
+	case CauseSep:
+		return ErrSep
+	case CauseQuote:
+		return ErrQuote
+	case CauseNul:
+		return ErrNul
+	case CauseUnmap:
+		return ErrUnmap
+	}
+	return fmt.Sprintf("Cause(%d)", int(c))
+}
+
+// Err returns the sentinel error for c.
+func (c Cause) Err() error {
+	switch c {
+	case CauseSep:
+		return ErrSeparator
+	case CauseQuote:
+		return ErrQuoted
+	case CauseNul:
+		return ErrNulByte
+	case CauseUnmap:
+		return ErrUnmapped
+	}
+	return nil
+}
+
+type Dialect int
+
+const (
+	Unix Dialect = iota
+	Windows
+	Plan9
+)
+
+func (d Dialect) Valid() bool {
+	return d == Unix || d == Windows || d == Plan9
+}
+
+// CheckDialect returns an error wrapping ErrDialect if d is not valid.
+func CheckDialect(d Dialect) error {
+	if !d.Valid() {
+		return fmt.Errorf("%w: %v", ErrDialect, d)
+	}
+	return nil
+}
+
+func (d Dialect) String() string {
+	switch d {
+	case Unix:
+		return "unix"
+	case Windows:
+		return "windows"
+	case Plan9:
+		return "plan9"
+	}
+	return fmt.Sprintf("Dialect(%d)", int(d))
+}
+
 const ListSeparator = os.PathListSeparator
 
 const (
@@ -36,8 +122,9 @@ const (
 )
 
 type Error struct {
-	Cause_    string
+	Cause_    Cause
 	Filepath_ string
+	Dialect_  Dialect
 }
 
 // Error implements the pathlist.Error interface.
@@ -46,7 +133,7 @@ func (e Error) Error() string {
 }
 
 func (e Error) Cause() string {
-	return e.Cause_
+	return e.Cause_.String()
 }
 
 func (e Error) Filepath() string {
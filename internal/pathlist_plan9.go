@@ -4,6 +4,12 @@
 
 package internal
 
+import (
+	"strings"
+)
+
+const Native = Plan9
+
 func NewElem(fp string) (string, error) {
 	return fp, nil
 }
@@ -12,3 +18,29 @@ func CloseQuote(el string) string {
 	// no quoting on Plan9
 	return el
 }
+
+func UnclosedQuote(l string) int {
+	// no quoting on Plan9
+	return -1
+}
+
+func RepairQuotes(l string) string {
+	// no quoting on Plan9
+	return l
+}
+
+func SplitElems(l string) []string {
+	return strings.Split(l, listsep)
+}
+
+func ElemEnd(l string) int {
+	if i := strings.IndexByte(l, listsep[0]); i >= 0 {
+		return i
+	}
+	return len(l)
+}
+
+func Unquote(e string) string {
+	// no quoting on Plan9
+	return e
+}
@@ -10,9 +10,11 @@ import (
 	"strings"
 )
 
+const Native = Unix
+
 func NewElem(fp string) (string, error) {
 	if strings.ContainsRune(fp, ListSeparator) {
-		return "", Error{Cause_: ErrSep, Filepath_: fp}
+		return "", Error{Cause_: CauseSep, Filepath_: fp, Dialect_: Native}
 	}
 	return fp, nil
 }
@@ -21,3 +23,29 @@ func CloseQuote(el string) string {
 	// no quoting on Unix
 	return el
 }
+
+func UnclosedQuote(l string) int {
+	// no quoting on Unix
+	return -1
+}
+
+func RepairQuotes(l string) string {
+	// no quoting on Unix
+	return l
+}
+
+func SplitElems(l string) []string {
+	return strings.Split(l, listsep)
+}
+
+func ElemEnd(l string) int {
+	if i := strings.IndexByte(l, listsep[0]); i >= 0 {
+		return i
+	}
+	return len(l)
+}
+
+func Unquote(e string) string {
+	// no quoting on Unix
+	return e
+}
@@ -8,9 +8,11 @@ import (
 	"strings"
 )
 
+const Native = Windows
+
 func NewElem(fp string) (string, error) {
 	if strings.ContainsRune(fp, '"') {
-		return "", Error{Cause_: ErrQuote, Filepath_: fp}
+		return "", Error{Cause_: CauseQuote, Filepath_: fp, Dialect_: Native}
 	}
 	if strings.ContainsRune(fp, ListSeparator) {
 		return `"` + fp + `"`, nil
@@ -18,6 +20,9 @@ func NewElem(fp string) (string, error) {
 	return fp, nil
 }
 
+// CloseQuote closes the quote left unclosed in el (if any) at the end of el,
+// so that el is interpreted the same way as by filepath.SplitList when other
+// elements are added.
 func CloseQuote(el string) string {
 	c := strings.Count(el, `"`)
 	if c%2 != 0 {
@@ -25,3 +30,23 @@ func CloseQuote(el string) string {
 	}
 	return el
 }
+
+func UnclosedQuote(l string) int {
+	return UnclosedQuoteWindows(l)
+}
+
+func RepairQuotes(l string) string {
+	return RepairQuotesWindows(l)
+}
+
+func SplitElems(l string) []string {
+	return SplitElemsWindows(l)
+}
+
+func ElemEnd(l string) int {
+	return ElemEndWindows(l)
+}
+
+func Unquote(e string) string {
+	return UnquoteWindows(e)
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ErrUnclosedQuote = "list must not contain unclosed quote" // Windows only
+
+// Quote handling for the Windows list format, independent of the OS so that
+// it can be tested anywhere.
+
+type QuoteError struct {
+	List_   string
+	Offset_ int
+}
+
+// Error implements the pathlist.QuoteError interface.
+func (e QuoteError) Error() string {
+	return fmt.Sprintf("pathlist: %s at offset %d; list: %#q", ErrUnclosedQuote,
+		e.Offset_, e.List_)
+}
+
+func (e QuoteError) List() string {
+	return e.List_
+}
+
+func (e QuoteError) Offset() int {
+	return e.Offset_
+}
+
+// Unwrap returns the sentinel error for the cause.
+func (e QuoteError) Unwrap() error {
+	return ErrUnclosed
+}
+
+// UnclosedQuoteWindows returns the offset of the quote left unclosed in l, or
+// -1 if all quotes are closed.
+func UnclosedQuoteWindows(l string) int {
+	off := -1
+	for i := 0; i < len(l); i++ {
+		if l[i] != '"' {
+			continue
+		}
+		if off < 0 {
+			off = i
+		} else {
+			off = -1
+		}
+	}
+	return off
+}
+
+// RepairQuotesWindows returns l with the unclosed quote removed.
+func RepairQuotesWindows(l string) string {
+	if off := UnclosedQuoteWindows(l); off >= 0 {
+		return l[:off] + l[off+1:]
+	}
+	return l
+}
+
+// ElemEndWindows returns the length of the first elem in l, ending at the
+// first separator outside quotes.
+func ElemEndWindows(l string) int {
+	quoted := false
+	for i := 0; i < len(l); i++ {
+		switch l[i] {
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return i
+			}
+		}
+	}
+	return len(l)
+}
+
+// SplitElemsWindows splits l into elems at separators outside quotes, keeping
+// the quotes, as filepath.SplitList does on Windows before unquoting.
+func SplitElemsWindows(l string) []string {
+	var es []string
+	for {
+		end := ElemEndWindows(l)
+		es = append(es, l[:end])
+		if end == len(l) {
+			return es
+		}
+		l = l[end+1:]
+	}
+}
+
+// UnquoteWindows returns the filepath held by the elem e.
+func UnquoteWindows(e string) string {
+	return strings.Replace(e, `"`, "", -1)
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"strings"
+	"testing"
+)
+
+var quoteTests = []struct {
+	l        string
+	unclosed int
+	repaired string
+}{
+	{``, -1, ``},
+	{`a;b`, -1, `a;b`},
+	{`"a;b";c`, -1, `"a;b";c`},
+	{`a"a`, 1, `aa`},
+	{`a"b;c`, 1, `ab;c`},
+	{`"a";"b;c`, 4, `"a";b;c`},
+	{`"a"b"`, 4, `"a"b`},
+	{`""""`, -1, `""""`},
+}
+
+func TestQuoteWindows(t *testing.T) {
+	for _, tt := range quoteTests {
+		if got := UnclosedQuoteWindows(tt.l); got != tt.unclosed {
+			t.Errorf("UnclosedQuoteWindows(%#q) = %d; want %d", tt.l, got, tt.unclosed)
+		}
+		got := RepairQuotesWindows(tt.l)
+		if got != tt.repaired {
+			t.Errorf("RepairQuotesWindows(%#q) = %#q; want %#q", tt.l, got, tt.repaired)
+		}
+		if UnclosedQuoteWindows(got) >= 0 {
+			t.Errorf("RepairQuotesWindows(%#q) = %#q, still unclosed", tt.l, got)
+		}
+	}
+}
+
+var splitElemsTests = []struct {
+	l  string
+	es []string
+}{
+	{``, []string{``}},
+	{`a;b`, []string{`a`, `b`}},
+	{`"a;b";c`, []string{`"a;b"`, `c`}},
+	{`a"b;c`, []string{`a"b;c`}},
+	{`;"x"y";"`, []string{``, `"x"y";"`}},
+	{`;"x"y;"`, []string{``, `"x"y`, `"`}},
+}
+
+func TestSplitElemsWindows(t *testing.T) {
+	for _, tt := range splitElemsTests {
+		es := SplitElemsWindows(tt.l)
+		if strings.Join(es, "|") != strings.Join(tt.es, "|") || len(es) != len(tt.es) {
+			t.Errorf("SplitElemsWindows(%#q) = %q; want %q", tt.l, es, tt.es)
+		}
+		if strings.Join(es, ";") != tt.l {
+			t.Errorf("SplitElemsWindows(%#q) = %q; not lossless", tt.l, es)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"strings"
+)
+
+// Filepath validation for any dialect, independent of the OS.
+
+// ValidateElem returns an Error if fp cannot appear in a list of dialect d,
+// including if it cannot be put in an environment variable, or an error
+// wrapping ErrDialect if d is not valid.
+// On Plan 9, NUL is the separator.
+func ValidateElem(d Dialect, fp string) error {
+	if err := CheckDialect(d); err != nil {
+		return err
+	}
+	nul := strings.IndexByte(fp, 0) >= 0
+	switch d {
+	case Unix:
+		if nul {
+			return Error{Cause_: CauseNul, Filepath_: fp, Dialect_: d}
+		}
+		if strings.IndexByte(fp, ':') >= 0 {
+			return Error{Cause_: CauseSep, Filepath_: fp, Dialect_: d}
+		}
+	case Windows:
+		if nul {
+			return Error{Cause_: CauseNul, Filepath_: fp, Dialect_: d}
+		}
+		if strings.IndexByte(fp, '"') >= 0 {
+			return Error{Cause_: CauseQuote, Filepath_: fp, Dialect_: d}
+		}
+	case Plan9:
+		if nul {
+			return Error{Cause_: CauseSep, Filepath_: fp, Dialect_: d}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// LenientNew is like New, but omits invalid filepaths instead of failing.
+// It returns the List of the remaining filepaths, along with an Errors
+// reporting each omitted filepath, or nil if there is none.
+func LenientNew(filepaths ...string) (List, error) {
+	elems, err := lenientElems(filepaths)
+	return List(internal.NewList(elems...)), err
+}
+
+// LenientAppendTo is like AppendTo, but omits invalid filepaths instead of
+// failing.
+// It returns list with the remaining filepaths appended, along with an Errors
+// reporting each omitted filepath, or nil if there is none.
+func LenientAppendTo(list List, filepaths ...string) (List, error) {
+	elems, err := lenientElems(filepaths)
+	l := list
+	for _, e := range elems {
+		l = List(internal.Append(string(l), e))
+	}
+	return l, err
+}
+
+// LenientPrependTo is like PrependTo, but omits invalid filepaths instead of
+// failing.
+// It returns list with the remaining filepaths prepended, along with an Errors
+// reporting each omitted filepath, or nil if there is none.
+func LenientPrependTo(list List, filepaths ...string) (List, error) {
+	elems, err := lenientElems(filepaths)
+	l := list
+	for i := range elems {
+		l = List(internal.Prepend(string(l), elems[len(elems)-i-1]))
+	}
+	return l, err
+}
+
+// lenientElems returns the elems for the valid filepaths, and an Errors for
+// the invalid ones (nil if none).
+func lenientElems(filepaths []string) ([]string, error) {
+	elems := make([]string, 0, len(filepaths))
+	var es Errors
+	for i, fp := range filepaths {
+		e, err := newElem(fp, i)
+		if err != nil {
+			es = append(es, err.(ArgError))
+			continue
+		}
+		elems = append(elems, e)
+	}
+	if es == nil {
+		return elems, nil
+	}
+	return elems, es
+}
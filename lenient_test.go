@@ -0,0 +1,45 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"testing"
+)
+
+func TestLenient(t *testing.T) {
+	if got, err := LenientAppendTo(colonToSep("a"), "b", ""); err != nil ||
+		got != colonToSep("a:b:") {
+		t.Errorf("LenientAppendTo(%#q, %q, %q) = %#q, %v; want %#q, nil",
+			colonToSep("a"), "b", "", got, err, colonToSep("a:b:"))
+	}
+	if invalidFilepath == "" {
+		t.Skip("no invalid filepath on this OS")
+	}
+	fps := []string{"b", invalidFilepath, "c"}
+	for _, tt := range []struct {
+		call string
+		f    func() (List, error)
+		want List
+	}{
+		{"LenientNew", func() (List, error) { return LenientNew(fps...) }, "b:c"},
+		{"LenientAppendTo", func() (List, error) {
+			return LenientAppendTo(colonToSep("a"), fps...)
+		}, "a:b:c"},
+		{"LenientPrependTo", func() (List, error) {
+			return LenientPrependTo(colonToSep("a"), fps...)
+		}, "b:c:a"},
+	} {
+		got, err := tt.f()
+		want := colonToSep(tt.want)
+		es, ok := err.(Errors)
+		if got != want || !ok || len(es) != 1 || es[0].Index != 1 ||
+			es[0].Path != invalidFilepath {
+			t.Errorf("%s(%q) = %#q, %v; want %#q, Errors for argument 1",
+				tt.call, fps, got, err, want)
+		} else {
+			t.Logf("%s(%q) = %#q, %v", tt.call, fps, got, err)
+		}
+	}
+}
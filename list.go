@@ -30,9 +30,9 @@
 //
 // In addition to using distinct types, filepath arguments are validated.
 // On Unix, filepaths containing the separator (':') cannot be used as part of a
-// List; these trigger an Error with Cause returning ErrSep.
-// On Windows, raw (unquoted) filepaths must be used; an Error with Cause
-// returning ErrQuote is issued otherwise.
+// List; these trigger an Error matching ErrSeparator (errors.Is).
+// On Windows, raw (unquoted) filepaths must be used; an Error matching
+// ErrQuoted is issued otherwise.
 // Calls returning (List, error) can be wrapped using Must when the validation
 // is known to succeed in advance.
 //
@@ -44,36 +44,132 @@
 // behavior is somewhat asymmetric.
 // On Unix, ':' is generally a valid (although uncommon) character in filepaths,
 // so an error typically indicates input error and the user should be notified.
+// Where such a directory must be used anyway, package shim
+// ( https://godoc.org/gopkg.in/pathlist.v0/shim ) can stand in a symlink for it.
 // In contrast, on Windows all filepaths can be included in a list; an error
 // generally means that the caller is mixing quoted and unquoted paths, which
 // likely indicates a bug and that the implementation should be fixed.
 package pathlist // import "gopkg.in/pathlist.v0"
 
 import (
+	"fmt"
 	"os"
 
 	"gopkg.in/pathlist.v0/internal"
 )
 
-// ErrSep and ErrQuote are returned by Error.Cause.
+// ErrSep, ErrQuote, ErrNul and ErrUnmap are returned by Error.Cause.
 const ( // replicated from internal to keep messages in godoc
 	ErrSep   = "filepath must not contain ListSeparator" // Unix only
 	ErrQuote = "filepath must not be quoted"             // Windows only
+	ErrNul   = "filepath must not contain NUL"           // Validate only
+	ErrUnmap = "filepath has no prefix mapping"          // Convert only
+)
+
+// Sentinel errors for the causes of an ArgError or QuoteError, matched using
+// errors.Is.
+var (
+	ErrSeparator error = internal.ErrSeparator // CauseSep
+	ErrQuoted    error = internal.ErrQuoted    // CauseQuote
+	ErrUnclosed  error = internal.ErrUnclosed  // QuoteError; Windows only
+	ErrNulByte   error = internal.ErrNulByte   // CauseNul
+	ErrUnmapped  error = internal.ErrUnmapped  // CauseUnmap
+)
+
+// ErrDialect is matched (using errors.Is) by the error returned for an
+// invalid Dialect.
+var ErrDialect error = internal.ErrDialect
+
+// Cause identifies the cause of an ArgError.
+// Its String method returns the corresponding Err* message constant, as
+// returned by Error.Cause.
+type Cause = internal.Cause
+
+// Causes of an ArgError.
+const (
+	CauseSep   = internal.CauseSep   // ErrSep; Unix only (Plan 9 for Validate)
+	CauseQuote = internal.CauseQuote // ErrQuote; Windows only
+	CauseNul   = internal.CauseNul   // ErrNul; Validate only
+	CauseUnmap = internal.CauseUnmap // ErrUnmap; Convert only
 )
 
 // ListSeparator is the OS-specific path list separator.
 const ListSeparator = os.PathListSeparator
 
+// Dialect identifies a list format.
+type Dialect = internal.Dialect
+
+// Dialects; Native is the dialect of the current OS.
+const (
+	Unix    = internal.Unix
+	Windows = internal.Windows
+	Plan9   = internal.Plan9
+	Native  = internal.Native
+)
+
 // Error holds a pathlist handling error.
-// Functions in this package return error values implementing this interface.
+// Functions in this package return error values implementing this interface,
+// of concrete type ArgError.
 type Error interface {
 	error
-	// Cause returns the cause of the error; either ErrSep or ErrQuote.
+	// Cause returns the cause of the error; one of ErrSep, ErrQuote, ErrNul
+	// and ErrUnmap.
 	Cause() string
 	// Filepath returns the offending filepath.
 	Filepath() string
 }
 
+// ArgError is the Error for an invalid filepath.
+// Use errors.As to retrieve it from a wrapped error, and errors.Is with a
+// sentinel error such as ErrSeparator to test its cause.
+type ArgError struct {
+	// Index is the index of the filepath among the filepath arguments of the
+	// call, or among the entries of the list for Convert, UnmarshalText and
+	// UnmarshalJSON.
+	Index int
+	// Path is the offending filepath.
+	Path string
+	// Reason is the cause of the error.
+	Reason Cause
+	// Dialect is the dialect of the list the filepath was meant for.
+	Dialect Dialect
+}
+
+func (e ArgError) Error() string {
+	return fmt.Sprintf("pathlist: %s; filepath: %#q", e.Reason, e.Path)
+}
+
+// Cause returns e.Reason as a string, implementing Error.
+func (e ArgError) Cause() string {
+	return e.Reason.String()
+}
+
+// Filepath returns e.Path, implementing Error.
+func (e ArgError) Filepath() string {
+	return e.Path
+}
+
+// Unwrap returns the sentinel error for e.Reason, such as ErrSeparator.
+func (e ArgError) Unwrap() error {
+	return e.Reason.Err()
+}
+
+// argError returns the ArgError for the internal.Error err and index i.
+func argError(err error, i int) ArgError {
+	ie := err.(internal.Error)
+	return ArgError{Index: i, Path: ie.Filepath_, Reason: ie.Cause_, Dialect: ie.Dialect_}
+}
+
+// newElem returns the elem for fp, the i-th filepath argument, or an
+// ArgError.
+func newElem(fp string, i int) (string, error) {
+	e, err := internal.NewElem(fp)
+	if err != nil {
+		return "", argError(err, i)
+	}
+	return e, nil
+}
+
 // List represents a list of zero or more filepaths joined by the OS-specific
 // ListSeparator, usually found in PATH or GOPATH environment variables.
 //
@@ -88,7 +184,7 @@ type List string
 func New(filepaths ...string) (List, error) {
 	elems := make([]string, len(filepaths))
 	for i, fp := range filepaths {
-		elem, err := internal.NewElem(fp)
+		elem, err := newElem(fp, i)
 		if err != nil {
 			return "", err
 		}
@@ -108,8 +204,8 @@ func Split(list List) []string {
 // AppendTo returns list with filepaths appended if valid, or returns an Error.
 func AppendTo(list List, filepaths ...string) (List, error) {
 	l := list
-	for _, filepath := range filepaths {
-		e, err := internal.NewElem(filepath)
+	for i, filepath := range filepaths {
+		e, err := newElem(filepath, i)
 		if err != nil {
 			return "", err
 		}
@@ -123,8 +219,8 @@ func AppendTo(list List, filepaths ...string) (List, error) {
 func PrependTo(list List, filepaths ...string) (List, error) {
 	l := list
 	for i := range filepaths {
-		filepath := filepaths[len(filepaths)-i-1]
-		e, err := internal.NewElem(filepath)
+		j := len(filepaths) - i - 1
+		e, err := newElem(filepaths[j], j)
 		if err != nil {
 			return "", err
 		}
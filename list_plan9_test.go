@@ -1,3 +1,5 @@
 package pathlist
 
 const invalidFilepath = "" // doesn't exist
+
+var invalidCause error
@@ -5,6 +5,8 @@
 package pathlist
 
 import (
+	"errors"
+	"fmt"
 	"runtime"
 	"strings"
 	"testing"
@@ -12,13 +14,22 @@ import (
 	"gopkg.in/pathlist.v0/internal"
 )
 
-var _ Error = internal.Error{}
+var _ Error = ArgError{}
+var _ QuoteError = internal.QuoteError{}
 
 var constTests = []struct {
 	exprStr, expr, want string
 }{
 	{exprStr: "ErrQuote", expr: ErrQuote, want: internal.ErrQuote},
 	{exprStr: "ErrSep", expr: ErrSep, want: internal.ErrSep},
+	{exprStr: "ErrNul", expr: ErrNul, want: internal.ErrNul},
+	{exprStr: "ErrNulByte.Error()", expr: ErrNulByte.Error(), want: ErrNul},
+	{exprStr: "ErrUnmap", expr: ErrUnmap, want: internal.ErrUnmap},
+	{exprStr: "ErrUnmapped.Error()", expr: ErrUnmapped.Error(), want: ErrUnmap},
+	{exprStr: "ErrSeparator.Error()", expr: ErrSeparator.Error(), want: ErrSep},
+	{exprStr: "ErrQuoted.Error()", expr: ErrQuoted.Error(), want: ErrQuote},
+	{exprStr: "ErrUnclosed.Error()", expr: ErrUnclosed.Error(),
+		want: internal.ErrUnclosedQuote},
 }
 
 // Ensure that error constants don't diverge.
@@ -235,6 +246,50 @@ func TestAppendToInvalidFilepath(t *testing.T) {
 	}
 }
 
+func TestErrorIsAs(t *testing.T) {
+	if invalidFilepath == "" {
+		t.Skip("no invalid filepath on this OS")
+	}
+	for _, tt := range []struct {
+		call  string
+		err   error
+		index int
+	}{
+		{"New", second(New("a", invalidFilepath)), 1},
+		{"AppendTo", second(AppendTo("", "a", "b", invalidFilepath)), 2},
+		{"PrependTo", second(PrependTo("", invalidFilepath, "a")), 0},
+	} {
+		err := fmt.Errorf("wrapped: %w", tt.err)
+		var ae ArgError
+		switch {
+		case !errors.Is(err, invalidCause):
+			t.Errorf("%s: errors.Is(%v, %v) = false; want true", tt.call, err, invalidCause)
+		case !errors.As(err, &ae):
+			t.Errorf("%s: errors.As(%v, &ae) = false; want true", tt.call, err)
+		case ae.Index != tt.index || ae.Dialect != Native || ae.Path != invalidFilepath ||
+			ae.Reason.Err() != invalidCause || ae.Cause() != invalidCause.Error():
+			t.Errorf("%s: %+v; want Index %d, Dialect %v, Path %q, cause %v",
+				tt.call, ae, tt.index, Native, invalidFilepath, invalidCause)
+		default:
+			t.Logf("%s: %v; %+v", tt.call, err, ae)
+		}
+	}
+}
+
+func second(_ List, err error) error {
+	return err
+}
+
+func TestDialectString(t *testing.T) {
+	for d, want := range map[Dialect]string{
+		Unix: "unix", Windows: "windows", Plan9: "plan9", Dialect(9): "Dialect(9)",
+	} {
+		if got := d.String(); got != want {
+			t.Errorf("Dialect(%d).String() = %q; want %q", int(d), got, want)
+		}
+	}
+}
+
 func TestMustOK(t *testing.T) {
 	want := colonToSep("a:b:c")
 	got := Must(AppendTo(colonToSep("a:b"), "c"))
@@ -4,3 +4,5 @@ package pathlist
 
 // invalid as in cannot be added to a pathlist
 const invalidFilepath = "c:/dir"
+
+var invalidCause = ErrSeparator
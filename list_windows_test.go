@@ -1,3 +1,5 @@
 package pathlist
 
 const invalidFilepath = `"ab"`
+
+var invalidCause = ErrQuoted
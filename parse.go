@@ -0,0 +1,110 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// Elem is an element of a Parsed list.
+type Elem struct {
+	// Raw is the text of the element as written, including any quotes.
+	Raw string
+	// Value is the (raw/unquoted) filepath held by the element.
+	Value string
+	// Start and End are the byte offsets of Raw in the parsed list, or -1
+	// for elements added or modified after parsing.
+	Start, End int
+}
+
+// Parsed is a List parsed into elements, preserving the text of each
+// element, so that a list can be edited without rewriting untouched
+// elements.
+// The zero value is the empty list.
+type Parsed struct {
+	Elems []Elem
+}
+
+// Parse parses list into elements.
+// The values of the elements are the filepaths returned by Split, and
+// p.List() == list for the result p.
+func Parse(list List) *Parsed {
+	p := &Parsed{}
+	off := 0
+	for _, raw := range elems(list) {
+		p.Elems = append(p.Elems, Elem{
+			Raw:   raw,
+			Value: internal.Unquote(raw),
+			Start: off,
+			End:   off + len(raw),
+		})
+		off += len(raw) + 1
+	}
+	return p
+}
+
+// List returns the list held by p, using the text of each element as
+// written.
+// On Windows, a quote left unclosed in an element other than the last one is
+// closed at the end of the element.
+func (p *Parsed) List() List {
+	raws := make([]string, len(p.Elems))
+	for i, e := range p.Elems {
+		raws[i] = e.Raw
+	}
+	return joinElems(raws)
+}
+
+// Filepaths returns the filepaths held by p.
+func (p *Parsed) Filepaths() []string {
+	fps := make([]string, len(p.Elems))
+	for i, e := range p.Elems {
+		fps[i] = e.Value
+	}
+	return fps
+}
+
+func newElems(filepaths []string) ([]Elem, error) {
+	es := make([]Elem, len(filepaths))
+	for i, fp := range filepaths {
+		raw, err := newElem(fp, i)
+		if err != nil {
+			return nil, err
+		}
+		es[i] = Elem{Raw: raw, Value: fp, Start: -1, End: -1}
+	}
+	return es, nil
+}
+
+// Set sets the filepath of the i-th element to filepath if valid, or returns
+// an Error.
+// The element is left untouched if it already holds filepath.
+func (p *Parsed) Set(i int, filepath string) error {
+	if p.Elems[i].Value == filepath {
+		return nil
+	}
+	es, err := newElems([]string{filepath})
+	if err != nil {
+		return err
+	}
+	p.Elems[i] = es[0]
+	return nil
+}
+
+// Insert inserts elements holding filepaths before the i-th element (or at
+// the end if i == len(p.Elems)) if valid, or returns an Error.
+func (p *Parsed) Insert(i int, filepaths ...string) error {
+	es, err := newElems(filepaths)
+	if err != nil {
+		return err
+	}
+	p.Elems = append(p.Elems[:i], append(es, p.Elems[i:]...)...)
+	return nil
+}
+
+// Delete removes the elements p.Elems[i:j].
+func (p *Parsed) Delete(i, j int) {
+	p.Elems = append(p.Elems[:i], p.Elems[j:]...)
+}
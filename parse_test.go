@@ -0,0 +1,81 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+var parseTests = []List{"", ":", "::", "a", "a:", ":b", "a::b", "/usr/bin:/bin:.:"}
+
+func TestParse(t *testing.T) {
+	for _, l := range parseTests {
+		l = colonToSep(l)
+		p := Parse(l)
+		if got := p.List(); got != l {
+			t.Errorf("Parse(%#q).List() = %#q; want %#q", l, got, l)
+		}
+		if got, want := p.Filepaths(), Split(l); len(got) != len(want) ||
+			len(got) > 0 && !reflect.DeepEqual(got, want) {
+			t.Errorf("Parse(%#q).Filepaths() = %q; want %q", l, got, want)
+		}
+		for _, e := range p.Elems {
+			if string(l[e.Start:e.End]) != e.Raw {
+				t.Errorf("Parse(%#q): elem %+v: span holds %q", l, e, l[e.Start:e.End])
+			}
+		}
+	}
+}
+
+func TestParseWindows(t *testing.T) {
+	if runtime.GOOS != "windows" {
+		t.Skip("windows-only test")
+	}
+	l := List(`C:\bin;"C:\a;b";C:\Pro"gram Fi"les\x`)
+	p := Parse(l)
+	want := []Elem{
+		{`C:\bin`, `C:\bin`, 0, 6},
+		{`"C:\a;b"`, `C:\a;b`, 7, 15},
+		{`C:\Pro"gram Fi"les\x`, `C:\Program Files\x`, 16, 36},
+	}
+	if !reflect.DeepEqual(p.Elems, want) {
+		t.Errorf("Parse(%#q) = %+v; want %+v", l, p.Elems, want)
+	}
+}
+
+func TestParsedEdit(t *testing.T) {
+	l := colonToSep("/a:/b::/c")
+	p := Parse(l)
+	if err := p.Set(0, "/a"); err != nil || p.List() != l {
+		t.Errorf("Set(0, %q): %#q, %v; want %#q, nil", "/a", p.List(), err, l)
+	}
+	if err := p.Set(1, "/x"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Insert(4, "/d"); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Insert(0, "/z"); err != nil {
+		t.Fatal(err)
+	}
+	p.Delete(3, 4)
+	want := colonToSep("/z:/a:/x:/c:/d")
+	if got := p.List(); got != want {
+		t.Errorf("edited Parse(%#q).List() = %#q; want %#q", l, got, want)
+	}
+	if p.Elems[1].Start != 0 || p.Elems[2].Start != -1 {
+		t.Errorf("edited Parse(%#q) spans = %+v", l, p.Elems)
+	}
+	if invalidFilepath != "" {
+		if err := p.Set(0, invalidFilepath); err == nil {
+			t.Errorf("Set(0, %q) = nil; want error", invalidFilepath)
+		}
+		if err := p.Insert(0, invalidFilepath); err == nil {
+			t.Errorf("Insert(0, %q) = nil; want error", invalidFilepath)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pathhelper builds filepath lists from the /etc/paths and
+// /etc/paths.d configuration used by the macOS path_helper utility.
+//
+// The configuration for a variable consists of the file etc/<name> and the
+// files in the directory etc/<name>.d, read in lexical order, each holding
+// one filepath per line.
+// Functions take an fs.FS rooted at the file system root (typically
+// os.DirFS("/")), so that the configuration can also be read from other
+// locations, such as a container image or a test fixture.
+package pathhelper // import "gopkg.in/pathlist.v0/pathhelper"
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/fs"
+	"path"
+
+	"gopkg.in/pathlist.v0"
+)
+
+// Configuration names used by path_helper.
+const (
+	Paths    = "paths"    // for PATH
+	Manpaths = "manpaths" // for MANPATH
+)
+
+// Read returns the filepaths listed in the configuration name in fsys, in
+// order and without duplicates.
+// Missing configuration files and directories are ignored.
+func Read(fsys fs.FS, name string) ([]string, error) {
+	var fps []string
+	seen := map[string]bool{}
+	add := func(file string) error {
+		data, err := fs.ReadFile(fsys, file)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		s := bufio.NewScanner(bytes.NewReader(data))
+		for s.Scan() {
+			fp := string(bytes.TrimSpace(s.Bytes()))
+			if fp == "" || seen[fp] {
+				continue
+			}
+			seen[fp] = true
+			fps = append(fps, fp)
+		}
+		return s.Err()
+	}
+
+	file := path.Join("etc", name)
+	if err := add(file); err != nil {
+		return nil, err
+	}
+	entries, err := fs.ReadDir(fsys, file+".d")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	for _, e := range entries { // sorted by ReadDir
+		if e.IsDir() {
+			continue
+		}
+		if err := add(path.Join(file+".d", e.Name())); err != nil {
+			return nil, err
+		}
+	}
+	return fps, nil
+}
+
+// Merge returns the List that path_helper would compute from the
+// configuration name in fsys and the current value list: the configured
+// filepaths, followed by the filepaths in list not already included.
+// An Error is returned if a configured filepath is invalid.
+func Merge(fsys fs.FS, name string, list pathlist.List) (pathlist.List, error) {
+	fps, err := Read(fsys, name)
+	if err != nil {
+		return "", err
+	}
+	seen := make(map[string]bool, len(fps))
+	for _, fp := range fps {
+		seen[fp] = true
+	}
+	for _, fp := range pathlist.Split(list) {
+		if seen[fp] {
+			continue
+		}
+		seen[fp] = true
+		fps = append(fps, fp)
+	}
+	return pathlist.New(fps...)
+}
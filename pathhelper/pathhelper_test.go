@@ -0,0 +1,52 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathhelper
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"gopkg.in/pathlist.v0"
+)
+
+var testFS = fstest.MapFS{
+	"etc/paths":            {Data: []byte("/usr/local/bin\n/usr/bin\n/bin\n\n")},
+	"etc/paths.d/20-go":    {Data: []byte("/usr/local/go/bin\n")},
+	"etc/paths.d/10-tex":   {Data: []byte("/Library/TeX/texbin\n/usr/bin\n")},
+	"etc/paths.d/sub/file": {Data: []byte("/ignored\n")},
+	"etc/manpaths":         {Data: []byte("/usr/share/man\n")},
+}
+
+func TestRead(t *testing.T) {
+	want := []string{"/usr/local/bin", "/usr/bin", "/bin", "/Library/TeX/texbin",
+		"/usr/local/go/bin"}
+	got, err := Read(testFS, Paths)
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Read(testFS, %q) = %q, %v; want %q, nil", Paths, got, err, want)
+	}
+	want = []string{"/usr/share/man"}
+	got, err = Read(testFS, Manpaths)
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Read(testFS, %q) = %q, %v; want %q, nil", Manpaths, got, err, want)
+	}
+	got, err = Read(fstest.MapFS{}, Paths)
+	if err != nil || len(got) != 0 {
+		t.Errorf("Read(empty, %q) = %q, %v; want [], nil", Paths, got, err)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	sep := string(pathlist.ListSeparator)
+	list := pathlist.List(strings.Join([]string{"/home/u/bin", "/bin", "/opt/bin"}, sep))
+	want := pathlist.List(strings.Join([]string{"/usr/local/bin", "/usr/bin", "/bin",
+		"/Library/TeX/texbin", "/usr/local/go/bin", "/home/u/bin", "/opt/bin"}, sep))
+	got, err := Merge(testFS, Paths, list)
+	if err != nil || got != want {
+		t.Errorf("Merge(testFS, %q, %#q) = %#q, %v; want %#q, nil", Paths, list,
+			got, err, want)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Parse parses a profile file read from r; see the package documentation for
+// the format.
+func Parse(layer string, r io.Reader) (*Profile, error) {
+	p := &Profile{Layer: layer}
+	var e *Entry
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(stripComment(s.Text()))
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[[") && strings.HasSuffix(line, "]]") {
+			name := strings.TrimSpace(line[2 : len(line)-2])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: missing variable name", n)
+			}
+			if err := checkEntry(e); err != nil {
+				return nil, err
+			}
+			p.Entries = append(p.Entries, Entry{Var: name, Line: n})
+			e = &p.Entries[len(p.Entries)-1]
+			continue
+		}
+		if e == nil {
+			return nil, fmt.Errorf("line %d: key outside of [[VARIABLE]] table", n)
+		}
+		i := strings.IndexByte(line, '=')
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: expected key = value", n)
+		}
+		if err := setKey(e, strings.TrimSpace(line[:i]),
+			strings.TrimSpace(line[i+1:])); err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if err := checkEntry(e); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func checkEntry(e *Entry) error {
+	if e != nil && e.Dir == "" {
+		return fmt.Errorf("line %d: [[%s]] entry without dir", e.Line, e.Var)
+	}
+	return nil
+}
+
+func setKey(e *Entry, key, val string) error {
+	switch key {
+	case "dir":
+		return parseString(val, &e.Dir)
+	case "position":
+		var pos string
+		if err := parseString(val, &pos); err != nil {
+			return err
+		}
+		switch pos {
+		case "front", "back":
+			e.Front = pos == "front"
+		default:
+			return fmt.Errorf("position must be \"front\" or \"back\": %q", pos)
+		}
+		return nil
+	case "if_exists":
+		return parseBool(val, &e.IfExists)
+	case "os":
+		return parseStrings(val, &e.OS)
+	}
+	return fmt.Errorf("unknown key %q", key)
+}
+
+// stripComment removes a # comment outside of strings from line.
+func stripComment(line string) string {
+	if i := indexUnquoted(line, '#'); i >= 0 {
+		return line[:i]
+	}
+	return line
+}
+
+// indexUnquoted returns the index of the first b outside of strings in s, or
+// -1.
+func indexUnquoted(s string, b byte) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == 0 && c == b:
+			return i
+		}
+	}
+	return -1
+}
+
+func parseString(val string, s *string) error {
+	if len(val) >= 2 && val[0] == '\'' && val[len(val)-1] == '\'' {
+		*s = val[1 : len(val)-1]
+		return nil
+	}
+	if len(val) < 2 || val[0] != '"' {
+		return fmt.Errorf("expected string: %s", val)
+	}
+	u, err := strconv.Unquote(val)
+	if err != nil {
+		return fmt.Errorf("invalid string: %s", val)
+	}
+	*s = u
+	return nil
+}
+
+func parseBool(val string, b *bool) error {
+	switch val {
+	case "true", "false":
+		*b = val == "true"
+		return nil
+	}
+	return fmt.Errorf("expected true or false: %s", val)
+}
+
+func parseStrings(val string, ss *[]string) error {
+	if len(val) < 2 || val[0] != '[' || val[len(val)-1] != ']' {
+		return fmt.Errorf("expected array of strings: %s", val)
+	}
+	*ss = nil
+	items := strings.TrimSpace(val[1 : len(val)-1])
+	for items != "" {
+		item, rest := items, ""
+		if i := indexUnquoted(items, ','); i >= 0 {
+			item, rest = items[:i], items[i+1:]
+		}
+		var s string
+		if err := parseString(strings.TrimSpace(item), &s); err != nil {
+			return err
+		}
+		*ss = append(*ss, s)
+		items = strings.TrimSpace(rest)
+	}
+	return nil
+}
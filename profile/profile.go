@@ -0,0 +1,163 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package profile applies declarative profiles of filepath list entries, such
+// as the directories a project needs in PATH, GOPATH or LD_LIBRARY_PATH.
+//
+// A profile file uses a subset of TOML: an array of tables per variable, each
+// table describing one entry:
+//
+//	# paths.toml
+//	[[PATH]]
+//	dir = "bin"             # relative to the directory of the profile file
+//	position = "front"      # "front" or "back" (default)
+//
+//	[[LD_LIBRARY_PATH]]
+//	dir = "/opt/cuda/lib64"
+//	if_exists = true        # only if the directory exists
+//	os = ["linux"]          # only on the listed operating systems
+//
+// Profiles are applied in layers (such as system, user and project), each
+// layer on top of the result of the previous ones, and Apply reports the
+// layer contributing each entry.
+package profile // import "gopkg.in/pathlist.v0/profile"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+// Entry is a filepath list entry described by a profile.
+type Entry struct {
+	Var      string   // variable name, such as "PATH"
+	Dir      string   // filepath, relative ones resolved against Profile.Base
+	Front    bool     // prepend rather than append
+	IfExists bool     // only if Dir is an existing directory
+	OS       []string // only if runtime.GOOS is listed, unless empty
+	Line     int      // line number in the profile file
+}
+
+// Profile is a parsed profile file.
+type Profile struct {
+	// Layer is the name of the profile in explanations, such as "system",
+	// "user" or "project".
+	Layer string
+	// Base is the directory relative entries are resolved against; relative
+	// entries are kept as is if Base is empty.
+	Base    string
+	Entries []Entry
+}
+
+// Load reads the profile file, with relative entries resolved against the
+// directory of file.
+func Load(layer, file string) (*Profile, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := Parse(layer, f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	if p.Base, err = filepath.Abs(filepath.Dir(file)); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Contribution explains the handling of a profile entry by Apply.
+type Contribution struct {
+	Layer string
+	Entry Entry
+	// Dir is the filepath added to the variable, after resolving relative
+	// entries.
+	Dir string
+	// Skipped is the reason the entry was not added, or empty if it was.
+	Skipped string
+}
+
+func (c Contribution) String() string {
+	pos := "back"
+	if c.Entry.Front {
+		pos = "front"
+	}
+	if c.Skipped != "" {
+		return fmt.Sprintf("%s: %s %q skipped (line %d): %s", c.Layer,
+			c.Entry.Var, c.Dir, c.Entry.Line, c.Skipped)
+	}
+	return fmt.Sprintf("%s: %s %q added to the %s (line %d)", c.Layer,
+		c.Entry.Var, c.Dir, pos, c.Entry.Line)
+}
+
+// Apply applies the profiles in layers in order onto the variables in envs
+// (as used with os.Environ and os/exec.Cmd.Env), using pathlist.PrependTo and
+// pathlist.AppendTo, and returns the resulting copy of envs together with
+// the contribution of each entry.
+// Within a layer, entries appear in the result in the order of the profile.
+// An error is returned if an entry is invalid, such as an Error from the
+// pathlist package.
+func Apply(envs []string, layers ...*Profile) ([]string, []Contribution, error) {
+	var contribs []Contribution
+	for _, p := range layers {
+		var vars []string
+		front, back := map[string][]string{}, map[string][]string{}
+		seen := map[string]bool{}
+		for _, e := range p.Entries {
+			c := Contribution{Layer: p.Layer, Entry: e, Dir: e.Dir}
+			if p.Base != "" && e.Dir != "" && !filepath.IsAbs(e.Dir) {
+				c.Dir = filepath.Join(p.Base, e.Dir)
+			}
+			c.Skipped = skipped(e, c.Dir)
+			contribs = append(contribs, c)
+			if c.Skipped != "" {
+				continue
+			}
+			if !seen[e.Var] {
+				seen[e.Var] = true
+				vars = append(vars, e.Var)
+			}
+			if e.Front {
+				front[e.Var] = append(front[e.Var], c.Dir)
+			} else {
+				back[e.Var] = append(back[e.Var], c.Dir)
+			}
+		}
+		for _, v := range vars {
+			list, err := pathlist.PrependTo(env.Slice(envs, v), front[v]...)
+			if err == nil {
+				list, err = pathlist.AppendTo(list, back[v]...)
+			}
+			if err != nil {
+				return nil, nil, fmt.Errorf("profile: layer %s: %s: %v", p.Layer, v, err)
+			}
+			envs = env.SetSlice(envs, v, list)
+		}
+	}
+	return envs, contribs, nil
+}
+
+// skipped returns the reason the conditions of e are not met, or empty.
+func skipped(e Entry, dir string) string {
+	if len(e.OS) > 0 {
+		match := false
+		for _, goos := range e.OS {
+			match = match || goos == runtime.GOOS
+		}
+		if !match {
+			return fmt.Sprintf("os %q not in %q", runtime.GOOS, e.OS)
+		}
+	}
+	if e.IfExists {
+		if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+			return "directory does not exist"
+		}
+	}
+	return ""
+}
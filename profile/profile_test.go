@@ -0,0 +1,115 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package profile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/env"
+)
+
+const testProfile = `# test profile
+[[PATH]]
+dir = "bin" # comment
+position = "front"
+
+[[PATH]]
+dir = '/opt/#tools'
+os = ["plan9", "nonexistent, os"]
+
+[[LD_LIBRARY_PATH]]
+dir = "/nonexistent/lib"
+if_exists = true
+`
+
+func TestParse(t *testing.T) {
+	want := &Profile{Layer: "project", Entries: []Entry{
+		{Var: "PATH", Dir: "bin", Front: true, Line: 2},
+		{Var: "PATH", Dir: "/opt/#tools", OS: []string{"plan9", "nonexistent, os"}, Line: 6},
+		{Var: "LD_LIBRARY_PATH", Dir: "/nonexistent/lib", IfExists: true, Line: 10},
+	}}
+	got, err := Parse("project", strings.NewReader(testProfile))
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %+v, %v; want %+v, nil", got, err, want)
+	}
+}
+
+var parseErrorTests = []string{
+	"dir = \"bin\"\n",
+	"[[PATH]]\n",
+	"[[PATH]]\ndir = bin\n",
+	"[[PATH]]\ndir = \"bin\"\nposition = \"middle\"\n",
+	"[[PATH]]\ndir = \"bin\"\nif_exists = yes\n",
+	"[[PATH]]\ndir = \"bin\"\nunknown = 1\n",
+	"[[]]\n",
+}
+
+func TestParseError(t *testing.T) {
+	for _, data := range parseErrorTests {
+		if p, err := Parse("test", strings.NewReader(data)); err == nil {
+			t.Errorf("Parse(%q) = %+v, nil; want error", data, p)
+		} else {
+			t.Logf("Parse(%q) = _, %v", data, err)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	dir, err := ioutil.TempDir("", "profile_test.TestApply_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "paths.toml")
+	if err := ioutil.WriteFile(file, []byte(testProfile), 0666); err != nil {
+		t.Fatal(err)
+	}
+	project, err := Load("project", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &Profile{Layer: "user", Entries: []Entry{
+		{Var: "PATH", Dir: "/home/u/bin", Front: true},
+		{Var: "PATH", Dir: "/home/u/go/bin", Front: true},
+		{Var: "GOPATH", Dir: "/home/u/go"},
+		{Var: "LD_LIBRARY_PATH", Dir: dir, IfExists: true},
+	}}
+
+	envs, contribs, err := Apply([]string{"PATH=/usr/bin:/bin"}, user, project)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wants := map[string]pathlist.List{
+		"PATH":            pathlist.List(dir + "/bin:/home/u/bin:/home/u/go/bin:/usr/bin:/bin"),
+		"GOPATH":          "/home/u/go",
+		"LD_LIBRARY_PATH": pathlist.List(dir),
+	}
+	for v, want := range wants {
+		if got := env.Slice(envs, v); got != want {
+			t.Errorf("Apply(): %s = %#q; want %#q", v, got, want)
+		}
+	}
+	var skipped []string
+	for _, c := range contribs {
+		t.Log(c)
+		if c.Skipped != "" {
+			skipped = append(skipped, c.Layer+" "+c.Dir)
+		}
+	}
+	wantSkipped := []string{"project /opt/#tools", "project /nonexistent/lib"}
+	if !reflect.DeepEqual(skipped, wantSkipped) {
+		t.Errorf("Apply(): skipped %q; want %q", skipped, wantSkipped)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// QuoteError holds the error for a List containing a quote left unclosed
+// (Windows only).
+// Functions in this package return error values implementing this interface.
+type QuoteError interface {
+	error
+	// List returns the offending list.
+	List() string
+	// Offset returns the byte offset of the unclosed quote in the list.
+	Offset() int
+	// Unwrap returns ErrUnclosed.
+	Unwrap() error
+}
+
+// CheckQuotes returns a QuoteError if list contains a quote left unclosed, or
+// nil otherwise.
+// Quotes are only significant on Windows; on other OSes, CheckQuotes always
+// returns nil.
+//
+// Split interprets the part of list following an unclosed quote as quoted, as
+// does filepath.SplitList, and AppendTo and PrependTo preserve this
+// interpretation by closing the quote at the end of list; for example,
+// AppendTo(`a"b;c`, "d") returns `a"b;c";d`.
+// Use StrictAppendTo and StrictPrependTo to reject such lists instead, or
+// RepairQuotes to remove the unclosed quote.
+func CheckQuotes(list List) error {
+	if off := internal.UnclosedQuote(string(list)); off >= 0 {
+		return internal.QuoteError{List_: string(list), Offset_: off}
+	}
+	return nil
+}
+
+// RepairQuotes returns list with the quote left unclosed (if any) removed, so
+// that it no longer quotes the rest of list; for example, `a"b;c` becomes
+// `ab;c`, holding filepaths "ab" and "c".
+// This restores the filepaths as most likely intended when a stray quote was
+// introduced, such as by truncating a quoted filepath.
+// Quotes are only significant on Windows; on other OSes, RepairQuotes returns
+// list unchanged.
+func RepairQuotes(list List) List {
+	return List(internal.RepairQuotes(string(list)))
+}
+
+// StrictAppendTo is like AppendTo, but returns a QuoteError if list contains a
+// quote left unclosed.
+func StrictAppendTo(list List, filepaths ...string) (List, error) {
+	if err := CheckQuotes(list); err != nil {
+		return "", err
+	}
+	return AppendTo(list, filepaths...)
+}
+
+// StrictPrependTo is like PrependTo, but returns a QuoteError if list contains
+// a quote left unclosed.
+func StrictPrependTo(list List, filepaths ...string) (List, error) {
+	if err := CheckQuotes(list); err != nil {
+		return "", err
+	}
+	return PrependTo(list, filepaths...)
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+)
+
+func TestCheckQuotes(t *testing.T) {
+	l := List(`a;"b;c`)
+	err := CheckQuotes(l)
+	if runtime.GOOS != "windows" {
+		if err != nil || RepairQuotes(l) != l {
+			t.Errorf("CheckQuotes(%#q) = %v; RepairQuotes(%#q) = %#q; want nil, %#q",
+				l, err, l, RepairQuotes(l), l)
+		}
+		return
+	}
+	qe, ok := err.(QuoteError)
+	if !ok || qe.Offset() != 2 || qe.List() != string(l) {
+		t.Errorf("CheckQuotes(%#q) = %v; want QuoteError at offset 2", l, err)
+	}
+	if !errors.Is(err, ErrUnclosed) {
+		t.Errorf("errors.Is(%v, ErrUnclosed) = false; want true", err)
+	}
+	want := []string{"a", "b", "c"}
+	if got := Split(RepairQuotes(l)); !equiv(got, want) {
+		t.Errorf("Split(RepairQuotes(%#q)) = %q; want %q", l, got, want)
+	}
+}
+
+func TestStrictAppendTo(t *testing.T) {
+	if runtime.GOOS != "windows" {
+		t.Skip("windows-only test")
+	}
+	for _, l := range []List{`a"a`, `"a;b`} {
+		if got, err := StrictAppendTo(l, "c"); err == nil {
+			t.Errorf("StrictAppendTo(%#q, %q) = %#q, nil; want error", l, "c", got)
+		}
+		if got, err := StrictPrependTo(l, "c"); err == nil {
+			t.Errorf("StrictPrependTo(%#q, %q) = %#q, nil; want error", l, "c", got)
+		}
+	}
+	l := List(`"a;b";c`)
+	if got, err := StrictAppendTo(l, "d"); err != nil || got != `"a;b";c;d` {
+		t.Errorf("StrictAppendTo(%#q, %q) = %#q, %v; want %#q, nil", l, "d", got, err,
+			`"a;b";c;d`)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// Scanner iterates over the (raw/unquoted) filepaths contained in a List,
+// yielding the same filepaths as Split without allocating a slice.
+// On Windows, unquoting an element containing quotes allocates its filepath.
+//
+// Typical use:
+//
+//	s := pathlist.NewScanner(list)
+//	for s.Next() {
+//		fp := s.Filepath()
+//		...
+//	}
+type Scanner struct {
+	rest  string
+	fp    string
+	index int
+	done  bool
+}
+
+// NewScanner returns a Scanner positioned before the first filepath in list.
+func NewScanner(list List) *Scanner {
+	s := &Scanner{rest: string(list), index: -1}
+	switch list {
+	case "":
+		s.done = true
+	case List(ListSeparator):
+		s.rest = ""
+	}
+	return s
+}
+
+// Next advances to the next filepath, reporting whether there is one.
+func (s *Scanner) Next() bool {
+	if s.done {
+		return false
+	}
+	end := internal.ElemEnd(s.rest)
+	s.fp = internal.Unquote(s.rest[:end])
+	s.index++
+	if end == len(s.rest) {
+		s.done = true
+	} else {
+		s.rest = s.rest[end+1:]
+	}
+	return true
+}
+
+// Filepath returns the current filepath.
+func (s *Scanner) Filepath() string {
+	return s.fp
+}
+
+// Index returns the index of the current filepath, as in the result of Split.
+func (s *Scanner) Index() int {
+	return s.index
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func scanAll(list List) []string {
+	fps := []string{}
+	for s := NewScanner(list); s.Next(); {
+		if s.Index() != len(fps) {
+			panic("unexpected index")
+		}
+		fps = append(fps, s.Filepath())
+	}
+	return fps
+}
+
+func TestScanner(t *testing.T) {
+	for _, l := range parseTests {
+		l = colonToSep(l)
+		got, want := scanAll(l), Split(l)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Scanner(%#q) = %q; want %q", l, got, want)
+		} else {
+			t.Logf("Scanner(%#q) = %q", l, got)
+		}
+	}
+}
+
+func TestScannerAllocs(t *testing.T) {
+	l := benchList()
+	n := testing.AllocsPerRun(10, func() {
+		for s := NewScanner(l); s.Next(); {
+			_ = s.Filepath()
+		}
+	})
+	if n != 0 {
+		t.Errorf("Scanner(%d-byte list) allocs = %v; want 0", len(l), n)
+	}
+}
+
+// benchList returns a long list resembling PATH.
+func benchList() List {
+	fps := make([]string, 100)
+	for i := range fps {
+		fps[i] = "/usr/local/some/tool/bin"
+	}
+	fps[len(fps)-1] = "/target/bin"
+	return List(strings.Join(fps, string(ListSeparator)))
+}
+
+func BenchmarkSplitFind(b *testing.B) {
+	l := benchList()
+	for i := 0; i < b.N; i++ {
+		for _, fp := range Split(l) {
+			if fp == "/target/bin" {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkScannerFind(b *testing.B) {
+	l := benchList()
+	for i := 0; i < b.N; i++ {
+		for s := NewScanner(l); s.Next(); {
+			if s.Filepath() == "/target/bin" {
+				break
+			}
+		}
+	}
+}
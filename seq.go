@@ -0,0 +1,25 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package pathlist
+
+import (
+	"iter"
+)
+
+// All returns an iterator over the indices and (raw/unquoted) filepaths
+// contained in list, yielding the same filepaths as Split without
+// allocating a slice; see Scanner.
+func All(list List) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for s := NewScanner(list); s.Next(); {
+			if !yield(s.Index(), s.Filepath()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package pathlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	for _, l := range parseTests {
+		l = colonToSep(l)
+		got := []string{}
+		for i, fp := range All(l) {
+			if i != len(got) {
+				t.Fatalf("All(%#q): index %d; want %d", l, i, len(got))
+			}
+			got = append(got, fp)
+		}
+		if want := Split(l); !reflect.DeepEqual(got, want) {
+			t.Errorf("All(%#q) = %q; want %q", l, got, want)
+		}
+	}
+	for i := range All(colonToSep("a:b:c")) {
+		if i > 0 {
+			t.Errorf("All: iteration continued after break")
+		}
+		break
+	}
+}
+
+func BenchmarkAllFind(b *testing.B) {
+	l := benchList()
+	for i := 0; i < b.N; i++ {
+		for _, fp := range All(l) {
+			if fp == "/target/bin" {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package shim makes directories that cannot appear in a filepath list
+// usable in one, by substituting a symlink to them.
+//
+// On Unix, a filepath containing the separator (':'), such as that of an
+// auto-mounted Windows share "/mnt/C:/tmp/bin", cannot be part of a
+// pathlist.List. A Cache creates a symlink to such a directory under a
+// managed directory, named after the filepath so that it is stable across
+// calls and processes, and uses the symlink in its place:
+//
+//	c := shim.Cache{Dir: filepath.Join(cacheDir, "pathlist-shims")}
+//	path, err := c.PrependTo(env.Path(), "/mnt/C:/tmp/bin")
+//	// path is "<cacheDir>/pathlist-shims/shim-<hash>:...", nil
+//
+// Filepaths that can appear in a list are used as is. Shims are only created
+// for filepaths rejected with pathlist.ErrSeparator; other errors are
+// returned.
+package shim // import "gopkg.in/pathlist.v0/shim"
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/pathlist.v0"
+)
+
+// prefix is the name prefix of shims; other files in Cache.Dir, including
+// temporary ones, are left alone.
+const prefix = "shim-"
+
+// Cache manages the shims in directory Dir, which is created as needed.
+// Dir must be absolute and must itself be representable in a List.
+type Cache struct {
+	Dir string
+}
+
+// Filepath returns fp if it can appear in a List, or otherwise the filepath
+// of a shim for it, creating or updating the shim as needed.
+// The filepath is made absolute first, so that the shim does not depend on
+// the working directory.
+func (c Cache) Filepath(fp string) (string, error) {
+	_, err := pathlist.New(fp)
+	if err == nil || !errors.Is(err, pathlist.ErrSeparator) {
+		return fp, err
+	}
+	if err := c.check(); err != nil {
+		return "", err
+	}
+	target, err := filepath.Abs(fp)
+	if err != nil {
+		return "", err
+	}
+	link := c.link(target)
+	cur, err := os.Readlink(link)
+	if err == nil && cur == target {
+		return link, nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+	if err := os.MkdirAll(c.Dir, 0o755); err != nil {
+		return "", err
+	}
+	// Create the link under a unique name and rename it into place, so that
+	// concurrent calls (possibly in other processes) each atomically install
+	// an identical link instead of failing.
+	tmp, err := os.MkdirTemp(c.Dir, ".tmp-")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+	tmpLink := filepath.Join(tmp, filepath.Base(link))
+	if err := os.Symlink(target, tmpLink); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmpLink, link); err != nil {
+		return "", err
+	}
+	return link, nil
+}
+
+// New is like pathlist.New, but substitutes shims as by Filepath.
+func (c Cache) New(filepaths ...string) (pathlist.List, error) {
+	fps, err := c.filepaths(filepaths)
+	if err != nil {
+		return "", err
+	}
+	return pathlist.New(fps...)
+}
+
+// AppendTo is like pathlist.AppendTo, but substitutes shims as by Filepath.
+func (c Cache) AppendTo(list pathlist.List, filepaths ...string) (pathlist.List, error) {
+	fps, err := c.filepaths(filepaths)
+	if err != nil {
+		return "", err
+	}
+	return pathlist.AppendTo(list, fps...)
+}
+
+// PrependTo is like pathlist.PrependTo, but substitutes shims as by
+// Filepath.
+func (c Cache) PrependTo(list pathlist.List, filepaths ...string) (pathlist.List, error) {
+	fps, err := c.filepaths(filepaths)
+	if err != nil {
+		return "", err
+	}
+	return pathlist.PrependTo(list, fps...)
+}
+
+// Target returns the filepath for which fp is a shim in c, and true, or fp
+// and false if it is not a shim in c.
+func (c Cache) Target(fp string) (string, bool) {
+	if !c.isShim(fp) {
+		return fp, false
+	}
+	target, err := os.Readlink(fp)
+	if err != nil {
+		return fp, false
+	}
+	return target, true
+}
+
+// Clean removes the stale shims in c: those whose target no longer exists,
+// and those not among the entries of the keep lists.
+// Clean without lists removes all shims.
+// A missing Dir is not an error.
+func (c Cache) Clean(keep ...pathlist.List) error {
+	used := map[string]bool{}
+	for _, l := range keep {
+		for _, fp := range pathlist.Split(l) {
+			if c.isShim(fp) {
+				used[filepath.Base(fp)] = true
+			}
+		}
+	}
+	des, err := os.ReadDir(c.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var errs []error
+	for _, de := range des {
+		name := de.Name()
+		if !strings.HasPrefix(name, prefix) || de.Type()&os.ModeSymlink == 0 {
+			continue
+		}
+		link := filepath.Join(c.Dir, name)
+		if used[name] {
+			if _, err := os.Stat(link); err == nil {
+				continue
+			}
+		}
+		if err := os.Remove(link); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c Cache) filepaths(filepaths []string) ([]string, error) {
+	fps := make([]string, len(filepaths))
+	for i, fp := range filepaths {
+		s, err := c.Filepath(fp)
+		if err != nil {
+			return nil, err
+		}
+		fps[i] = s
+	}
+	return fps, nil
+}
+
+func (c Cache) check() error {
+	if !filepath.IsAbs(c.Dir) {
+		return errors.New("shim: cache directory must be absolute: " + c.Dir)
+	}
+	_, err := pathlist.New(c.Dir)
+	return err
+}
+
+func (c Cache) link(target string) string {
+	sum := sha256.Sum256([]byte(target))
+	return filepath.Join(c.Dir, prefix+hex.EncodeToString(sum[:8]))
+}
+
+func (c Cache) isShim(fp string) bool {
+	return c.Dir != "" && filepath.Dir(fp) == filepath.Clean(c.Dir) &&
+		strings.HasPrefix(filepath.Base(fp), prefix)
+}
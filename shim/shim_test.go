@@ -0,0 +1,127 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package shim_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+
+	"gopkg.in/pathlist.v0"
+	"gopkg.in/pathlist.v0/shim"
+)
+
+func TestCache(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	tmp := t.TempDir()
+	bad := filepath.Join(tmp, "mnt", "C:", "bin")
+	if err := os.MkdirAll(bad, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	c := shim.Cache{Dir: filepath.Join(tmp, "shims")}
+
+	l, err := c.AppendTo("/usr/bin", "/bin", bad)
+	if err != nil {
+		t.Fatalf("AppendTo(%q, %q, %q) = %v", "/usr/bin", "/bin", bad, err)
+	}
+	fps := pathlist.Split(l)
+	if len(fps) != 3 || fps[1] != "/bin" || filepath.Dir(fps[2]) != c.Dir {
+		t.Fatalf("AppendTo(%q, %q, %q) = %#q; want shim for %q", "/usr/bin",
+			"/bin", bad, l, bad)
+	}
+	t.Logf("AppendTo(%q, %q, %q) = %#q", "/usr/bin", "/bin", bad, l)
+	link := fps[2]
+	if target, ok := c.Target(link); !ok || target != bad {
+		t.Errorf("Target(%q) = %q, %v; want %q, true", link, target, ok, bad)
+	}
+	if fi, err := os.Stat(link); err != nil || !fi.IsDir() {
+		t.Errorf("os.Stat(%q) = %v, %v; want directory", link, fi, err)
+	}
+	if l2, err := c.PrependTo("", bad); err != nil || l2 != pathlist.List(link) {
+		t.Errorf("PrependTo(%q, %q) = %#q, %v; want stable shim %#q", "", bad,
+			l2, err, link)
+	}
+	if _, ok := c.Target("/bin"); ok {
+		t.Errorf("Target(%q) = _, true; want false", "/bin")
+	}
+
+	// in use, target exists
+	if err := c.Clean(l); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Lstat(link); err != nil {
+		t.Errorf("Clean(%#q) removed shim in use: %v", l, err)
+	}
+	// in use, target removed
+	if err := os.Remove(bad); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Clean(l); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Lstat(link); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Clean(%#q) kept dangling shim: %v", l, err)
+	}
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	tmp := t.TempDir()
+	bad := filepath.Join(tmp, "mnt", "C:", "bin")
+	if err := os.MkdirAll(bad, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	c := shim.Cache{Dir: filepath.Join(tmp, "shims")}
+	const n = 16
+	links := make([]string, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			links[i], errs[i] = c.Filepath(bad)
+		}(i)
+	}
+	wg.Wait()
+	for i := range links {
+		if errs[i] != nil || links[i] != links[0] {
+			t.Errorf("Filepath(%q) #%d = %q, %v; want %q, nil", bad, i, links[i],
+				errs[i], links[0])
+		}
+	}
+	if target, ok := c.Target(links[0]); !ok || target != bad {
+		t.Errorf("Target(%q) = %q, %v; want %q, true", links[0], target, ok, bad)
+	}
+	des, err := os.ReadDir(c.Dir)
+	if err != nil || len(des) != 1 {
+		t.Errorf("os.ReadDir(%q) = %v, %v; want only the shim", c.Dir, des, err)
+	}
+}
+
+func TestCacheErrors(t *testing.T) {
+	if runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		t.Skip("unix-only test")
+	}
+	for _, c := range []shim.Cache{{Dir: "rel"}, {Dir: "/tmp/a:b"}} {
+		if fp, err := c.Filepath("/mnt/C:/bin"); err == nil {
+			t.Errorf("%+v.Filepath(%q) = %q, nil; want error", c, "/mnt/C:/bin", fp)
+		}
+	}
+	c := shim.Cache{Dir: filepath.Join(t.TempDir(), "missing")}
+	if fp, err := c.Filepath("/bin"); err != nil || fp != "/bin" {
+		t.Errorf("Filepath(%q) = %q, %v; want %q, nil", "/bin", fp, err, "/bin")
+	}
+	if err := c.Clean(); err != nil {
+		t.Errorf("Clean() on missing directory = %v; want nil", err)
+	}
+}
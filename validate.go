@@ -0,0 +1,31 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"gopkg.in/pathlist.v0/internal"
+)
+
+// Validate returns an ArgError if filepath cannot appear in a List of dialect d,
+// an error matching ErrDialect if d is not a known Dialect, or nil otherwise.
+// In addition to the separator (Unix) and quote (Windows) checks done by New,
+// Validate rejects filepaths containing NUL, which cannot be put in an
+// environment variable; on Plan 9, NUL is the separator itself.
+func Validate(d Dialect, filepath string) error {
+	switch err := internal.ValidateElem(d, filepath); err.(type) {
+	case nil:
+		return nil
+	case internal.Error:
+		return argError(err, 0)
+	default:
+		return err
+	}
+}
+
+// CanRepresent reports whether filepath can appear in a List of dialect d,
+// as validated by Validate.
+func CanRepresent(d Dialect, filepath string) bool {
+	return Validate(d, filepath) == nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Péter Surányi. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pathlist
+
+import (
+	"errors"
+	"testing"
+)
+
+var validateTests = []struct {
+	d     Dialect
+	fp    string
+	cause error
+}{
+	{Unix, "", nil},
+	{Unix, "/usr/bin", nil},
+	{Unix, `"a;b"`, nil},
+	{Unix, "c:/dir", ErrSeparator},
+	{Unix, "a\x00b", ErrNulByte},
+	{Windows, `C:\bin`, nil},
+	{Windows, `a;b`, nil},
+	{Windows, `"a"`, ErrQuoted},
+	{Windows, "a\x00b", ErrNulByte},
+	{Plan9, "/bin", nil},
+	{Plan9, "a:b;c", nil},
+	{Plan9, "a\x00b", ErrSeparator},
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range validateTests {
+		err := Validate(tt.d, tt.fp)
+		var ae ArgError
+		switch {
+		case tt.cause == nil && err != nil, tt.cause != nil && !errors.Is(err, tt.cause):
+			t.Errorf("Validate(%v, %q) = %v; want %v", tt.d, tt.fp, err, tt.cause)
+		case err != nil && (!errors.As(err, &ae) || ae.Dialect != tt.d):
+			t.Errorf("Validate(%v, %q) = %#v; want Error with dialect %v",
+				tt.d, tt.fp, err, tt.d)
+		case CanRepresent(tt.d, tt.fp) != (tt.cause == nil):
+			t.Errorf("CanRepresent(%v, %q) = %v; want %v", tt.d, tt.fp,
+				tt.cause != nil, tt.cause == nil)
+		default:
+			t.Logf("Validate(%v, %q) = %v", tt.d, tt.fp, err)
+		}
+	}
+}
+
+// Validate must reject what New rejects for the native dialect.
+func TestValidateNative(t *testing.T) {
+	if invalidFilepath == "" {
+		t.Skip("no invalid filepath on this OS")
+	}
+	if err := Validate(Native, invalidFilepath); !errors.Is(err, invalidCause) {
+		t.Errorf("Validate(Native, %q) = %v; want %v", invalidFilepath, err, invalidCause)
+	}
+}
+
+func TestValidateInvalidDialect(t *testing.T) {
+	d := Dialect(99)
+	if err := Validate(d, "a"); !errors.Is(err, ErrDialect) {
+		t.Errorf("Validate(%v, %q) = %v; want ErrDialect", d, "a", err)
+	} else {
+		t.Logf("Validate(%v, %q) = %v", d, "a", err)
+	}
+	if CanRepresent(d, "a") {
+		t.Errorf("CanRepresent(%v, %q) = true; want false", d, "a")
+	}
+	if got, err := Convert("a", Unix, d); !errors.Is(err, ErrDialect) {
+		t.Errorf("Convert(%#q, Unix, %v) = %#q, %v; want ErrDialect", "a", d, got, err)
+	}
+}